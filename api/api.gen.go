@@ -10,39 +10,385 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CalculationJobStatus.
+const (
+	Cancelled CalculationJobStatus = "cancelled"
+	Failed    CalculationJobStatus = "failed"
+	Queued    CalculationJobStatus = "queued"
+	Running   CalculationJobStatus = "running"
+	Succeeded CalculationJobStatus = "succeeded"
+)
+
+// Adjustment defines model for Adjustment.
+type Adjustment struct {
+	Amount float64 `json:"amount"`
+	Name   string  `json:"name"`
+}
+
 // AllTaxBracketResponses defines model for AllTaxBracketResponses.
 type AllTaxBracketResponses map[string]TaxBracketResponses
 
+// BandV2 defines model for BandV2.
+type BandV2 struct {
+	// Max Upper limit of the band, or null for the open-ended top band.
+	Max     *float64 `json:"max"`
+	Min     float64  `json:"min"`
+	Rate    float64  `json:"rate"`
+	TaxOwed float64  `json:"tax_owed"`
+
+	// TaxableAmount Part of the taxable income that falls in the band.
+	TaxableAmount float64 `json:"taxable_amount"`
+}
+
+// BonusRequest defines model for BonusRequest.
+type BonusRequest struct {
+	Bonus float64 `json:"bonus"`
+
+	// CanadianSourceIncome Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome float64 `json:"canadian_source_income,omitempty"`
+
+	// Deductions Deductions, e.g. RRSP contributions, subtracted from the salary before the brackets are applied.
+	Deductions float64 `json:"deductions,omitempty"`
+
+	// FilingStatus US filing status, one of `single`, `married_filing_jointly`,
+	// `married_filing_separately` or `head_of_household`. Required for the `US` jurisdiction.
+	FilingStatus string `json:"filing_status,omitempty"`
+
+	// IncomeItems Other income items, e.g. foreign employment income, added to the salary. Items in another currency
+	// are converted with the annual-average exchange rate of the tax year.
+	IncomeItems []IncomeItemInput `json:"income_items,omitempty"`
+
+	// InstalmentsPaid Tax instalments already paid for the year.
+	InstalmentsPaid float64 `json:"instalments_paid,omitempty"`
+
+	// Jurisdiction Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+
+	// NonResident Set for non-residents of Canada, who are taxed on their Canadian-source income only.
+	NonResident bool `json:"non_resident,omitempty"`
+
+	// Province Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	// Provincial tax is added to the federal tax when a province is given.
+	Province string `json:"province,omitempty"`
+
+	// ResidencyEndDate Last day of residence in Canada, for emigrants.
+	ResidencyEndDate *openapi_types.Date `json:"residency_end_date,omitempty"`
+
+	// ResidencyStartDate First day of residence in Canada, for immigrants. The salary is the income earned while resident
+	// and the non-refundable credits are prorated by the days resident.
+	ResidencyStartDate *openapi_types.Date `json:"residency_start_date,omitempty"`
+	Salary             float64             `json:"salary"`
+
+	// TaxWithheld Income tax already withheld at source, e.g. from pay slips.
+	TaxWithheld float64 `json:"tax_withheld,omitempty"`
+}
+
+// BonusResponse defines model for BonusResponse.
+type BonusResponse struct {
+	Bonus float64 `json:"bonus"`
+
+	// BonusTaxRate Incremental tax as a fraction of the bonus.
+	BonusTaxRate float64 `json:"bonus_tax_rate"`
+
+	// IncrementalContributions Payroll contributions on the salary and the bonus less the payroll contributions on the salary.
+	IncrementalContributions float64 `json:"incremental_contributions"`
+
+	// IncrementalTax Total tax owed on the salary and the bonus less the total tax owed on the salary.
+	IncrementalTax float64 `json:"incremental_tax"`
+
+	// NetBonus Bonus less the withholding.
+	NetBonus  float64           `json:"net_bonus"`
+	Salary    float64           `json:"salary"`
+	TaxYear   string            `json:"tax_year"`
+	WithBonus CalculateResponse `json:"with_bonus,omitempty"`
+
+	// Withholding Amount to withhold at source from the bonus, i.e. the incremental tax and contributions.
+	Withholding  float64           `json:"withholding"`
+	WithoutBonus CalculateResponse `json:"without_bonus,omitempty"`
+}
+
 // CalculateRequest defines model for CalculateRequest.
 type CalculateRequest struct {
-	Salary float32 `json:"salary"`
+	// CanadianSourceIncome Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome float64 `json:"canadian_source_income,omitempty"`
+
+	// Deductions Deductions, e.g. RRSP contributions, subtracted from the salary before the brackets are applied.
+	Deductions float64 `json:"deductions,omitempty"`
+
+	// FilingStatus US filing status, one of `single`, `married_filing_jointly`,
+	// `married_filing_separately` or `head_of_household`. Required for the `US` jurisdiction.
+	FilingStatus string `json:"filing_status,omitempty"`
+
+	// IncomeItems Other income items, e.g. foreign employment income, added to the salary. Items in another currency
+	// are converted with the annual-average exchange rate of the tax year.
+	IncomeItems []IncomeItemInput `json:"income_items,omitempty"`
+
+	// InstalmentsPaid Tax instalments already paid for the year.
+	InstalmentsPaid float64 `json:"instalments_paid,omitempty"`
+
+	// Jurisdiction Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+
+	// NonResident Set for non-residents of Canada, who are taxed on their Canadian-source income only.
+	NonResident bool `json:"non_resident,omitempty"`
+
+	// Province Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	// Provincial tax is added to the federal tax when a province is given.
+	Province string `json:"province,omitempty"`
+
+	// ResidencyEndDate Last day of residence in Canada, for emigrants.
+	ResidencyEndDate *openapi_types.Date `json:"residency_end_date,omitempty"`
+
+	// ResidencyStartDate First day of residence in Canada, for immigrants. The salary is the income earned while resident
+	// and the non-refundable credits are prorated by the days resident.
+	ResidencyStartDate *openapi_types.Date `json:"residency_start_date,omitempty"`
+	Salary             float64             `json:"salary"`
+
+	// TaxWithheld Income tax already withheld at source, e.g. from pay slips.
+	TaxWithheld float64 `json:"tax_withheld,omitempty"`
 }
 
 // CalculateResponse defines model for CalculateResponse.
 type CalculateResponse struct {
+	// BalanceOwing Total tax owed not yet paid, returned, even when it is 0, when tax was withheld or paid by instalments.
+	BalanceOwing *float64 `json:"balance_owing,omitempty"`
+
+	// Contributions Payroll contributions, i.e. CPP and EI, or QPP, QPIP and EI for Quebec residents.
+	Contributions []Adjustment `json:"contributions,omitempty"`
+
+	// Credits Credits subtracted from the tax, e.g. the Quebec federal abatement.
+	Credits []Adjustment `json:"credits,omitempty"`
+
+	// DatasetVersion Hash of the dataset the calculation is built from.
+	DatasetVersion   string       `json:"dataset_version,omitempty"`
+	Deductions       float64      `json:"deductions,omitempty"`
 	EffectiveTaxRate string       `json:"effective_tax_rate"`
-	Salary           float32      `json:"salary"`
-	TaxOwedPerBand   []TaxBracket `json:"tax_owed_per_band"`
-	TaxYear          string       `json:"tax_year"`
-	TotalTaxOwed     float32      `json:"total_tax_owed"`
+	FederalTaxOwed   float64      `json:"federal_tax_owed,omitempty"`
+	FilingStatus     string       `json:"filing_status,omitempty"`
+	IncomeItems      []IncomeItem `json:"income_items,omitempty"`
+	InstalmentsPaid  float64      `json:"instalments_paid,omitempty"`
+	Jurisdiction     string       `json:"jurisdiction,omitempty"`
+
+	// NetIncome Total income less the total tax owed and the payroll contributions.
+	NetIncome float64 `json:"net_income,omitempty"`
+
+	// Provenance Where the federal tax bracket of a tax year comes from.
+	Provenance *TaxYearProvenance `json:"provenance,omitempty"`
+	Province   string             `json:"province,omitempty"`
+
+	// ProvincialTaxAuthority Authority the provincial return is filed with. Quebec residents file their provincial
+	// return with Revenu Québec, separately from the federal return.
+	ProvincialTaxAuthority   string       `json:"provincial_tax_authority,omitempty"`
+	ProvincialTaxOwed        float64      `json:"provincial_tax_owed,omitempty"`
+	ProvincialTaxOwedPerBand []TaxBracket `json:"provincial_tax_owed_per_band,omitempty"`
+
+	// Refund Amount paid in excess of the total tax owed, returned, even when it is 0, when tax was withheld or paid by instalments.
+	Refund *float64 `json:"refund,omitempty"`
+
+	// Residency Residency of part-year residents and non-residents, and the proration factors it implies.
+	Residency *Residency `json:"residency,omitempty"`
+
+	// Rounding How the amounts of the calculation are rounded, as set for the jurisdiction in the dataset.
+	Rounding          *RoundingPolicy `json:"rounding,omitempty"`
+	Salary            float64         `json:"salary"`
+	StandardDeduction float64         `json:"standard_deduction,omitempty"`
+	TaxOwedPerBand    []TaxBracket    `json:"tax_owed_per_band"`
+	TaxWithheld       float64         `json:"tax_withheld,omitempty"`
+	TaxYear           string          `json:"tax_year"`
+	TaxableIncome     float64         `json:"taxable_income,omitempty"`
+
+	// TotalIncome Salary plus the converted income items.
+	TotalIncome  float64 `json:"total_income,omitempty"`
+	TotalTaxOwed float64 `json:"total_tax_owed"`
+}
+
+// CalculateResponseV2 defines model for CalculateResponseV2.
+type CalculateResponseV2 struct {
+	BalanceOwing  float64      `json:"balance_owing"`
+	Bands         []BandV2     `json:"bands"`
+	Contributions []Adjustment `json:"contributions"`
+	Credits       []Adjustment `json:"credits"`
+
+	// Currency ISO 4217 currency code of the amounts.
+	Currency       string  `json:"currency"`
+	DatasetVersion string  `json:"dataset_version"`
+	Deductions     float64 `json:"deductions"`
+
+	// EffectiveTaxRate Total tax owed as a fraction of the total income, e.g. 0.3123.
+	EffectiveTaxRate float64      `json:"effective_tax_rate"`
+	FederalTaxOwed   float64      `json:"federal_tax_owed"`
+	FilingStatus     *string      `json:"filing_status"`
+	IncomeItems      []IncomeItem `json:"income_items"`
+	InstalmentsPaid  float64      `json:"instalments_paid"`
+	Jurisdiction     string       `json:"jurisdiction"`
+
+	// MarginalTaxRate Sum of the federal and provincial rates of the bands the taxable income falls in.
+	MarginalTaxRate float64 `json:"marginal_tax_rate"`
+	NetIncome       float64 `json:"net_income"`
+
+	// Provenance Where the federal tax bracket of a tax year comes from.
+	Provenance             *TaxYearProvenance `json:"provenance,omitempty"`
+	Province               *string            `json:"province"`
+	ProvincialBands        []BandV2           `json:"provincial_bands"`
+	ProvincialTaxAuthority *string            `json:"provincial_tax_authority"`
+	ProvincialTaxOwed      float64            `json:"provincial_tax_owed"`
+	Refund                 float64            `json:"refund"`
+
+	// Residency Residency of part-year residents and non-residents, and the proration factors it implies.
+	Residency *Residency `json:"residency,omitempty"`
+
+	// Rounding How the amounts of the calculation are rounded, as set for the jurisdiction in the dataset.
+	Rounding          RoundingPolicy `json:"rounding"`
+	Salary            float64        `json:"salary"`
+	StandardDeduction *float64       `json:"standard_deduction"`
+	TaxWithheld       float64        `json:"tax_withheld"`
+	TaxYear           string         `json:"tax_year"`
+	TaxableIncome     float64        `json:"taxable_income"`
+	TotalIncome       float64        `json:"total_income"`
+	TotalTaxOwed      float64        `json:"total_tax_owed"`
+}
+
+// CalculationJob defines model for CalculationJob.
+type CalculationJob struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error Why the job failed.
+	Error string `json:"error,omitempty"`
+
+	// ExpiresAt When the finished job and its results are deleted.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Failed Number of calculations done so far whose input is invalid, with an error in their result.
+	Failed     int        `json:"failed"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Id         string     `json:"id"`
+
+	// Processed Number of calculations done so far.
+	Processed int `json:"processed"`
+
+	// Progress Fraction of the calculations done so far, e.g. 0.25.
+	Progress float64 `json:"progress"`
+
+	// ResultsUrl URL of the results, once the job has succeeded.
+	ResultsUrl string     `json:"results_url,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+
+	// Status One of `queued`, `running`, `succeeded`, `failed` when the results could not be written, or
+	// `cancelled`.
+	Status CalculationJobStatus `json:"status"`
+
+	// Total Number of calculations of the job.
+	Total int `json:"total"`
+
+	// Year Tax year of the calculations, once a symbolic year is resolved.
+	Year string `json:"year"`
+}
+
+// CalculationJobStatus One of `queued`, `running`, `succeeded`, `failed` when the results could not be written, or
+// `cancelled`.
+type CalculationJobStatus string
+
+// CalculationJobInput defines model for CalculationJobInput.
+type CalculationJobInput struct {
+	// CanadianSourceIncome Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome float64 `json:"canadian_source_income,omitempty"`
+
+	// Deductions Deductions, e.g. RRSP contributions, subtracted from the salary before the brackets are applied.
+	Deductions float64 `json:"deductions,omitempty"`
+
+	// FilingStatus US filing status, one of `single`, `married_filing_jointly`,
+	// `married_filing_separately` or `head_of_household`. Required for the `US` jurisdiction.
+	FilingStatus string `json:"filing_status,omitempty"`
+	Id           string `json:"id,omitempty"`
+
+	// IncomeItems Other income items, e.g. foreign employment income, added to the salary. Items in another currency
+	// are converted with the annual-average exchange rate of the tax year.
+	IncomeItems []IncomeItemInput `json:"income_items,omitempty"`
+
+	// InstalmentsPaid Tax instalments already paid for the year.
+	InstalmentsPaid float64 `json:"instalments_paid,omitempty"`
+
+	// Jurisdiction Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+
+	// NonResident Set for non-residents of Canada, who are taxed on their Canadian-source income only.
+	NonResident bool `json:"non_resident,omitempty"`
+
+	// Province Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	// Provincial tax is added to the federal tax when a province is given.
+	Province string `json:"province,omitempty"`
+
+	// ResidencyEndDate Last day of residence in Canada, for emigrants.
+	ResidencyEndDate *openapi_types.Date `json:"residency_end_date,omitempty"`
+
+	// ResidencyStartDate First day of residence in Canada, for immigrants. The salary is the income earned while resident
+	// and the non-refundable credits are prorated by the days resident.
+	ResidencyStartDate *openapi_types.Date `json:"residency_start_date,omitempty"`
+	Salary             float64             `json:"salary"`
+
+	// TaxWithheld Income tax already withheld at source, e.g. from pay slips.
+	TaxWithheld float64 `json:"tax_withheld,omitempty"`
+}
+
+// CalculationJobRequest defines model for CalculationJobRequest.
+type CalculationJobRequest struct {
+	Calculations []CalculationJobInput `json:"calculations"`
+
+	// Year Tax year of the calculations, or one of the symbolic years `latest`, `current` or `previous`.
+	Year string `json:"year"`
+}
+
+// CompareScenariosRequest defines model for CompareScenariosRequest.
+type CompareScenariosRequest struct {
+	// Baseline Name of the scenario the others are compared against. Defaults to the first scenario.
+	Baseline  string          `json:"baseline,omitempty"`
+	Scenarios []ScenarioInput `json:"scenarios"`
 }
 
-// ErrorResponses defines model for ErrorResponses.
+// CompareScenariosResponse defines model for CompareScenariosResponse.
+type CompareScenariosResponse struct {
+	Baseline  string           `json:"baseline"`
+	Scenarios []ScenarioResult `json:"scenarios"`
+}
+
+// ErrorResponses Problem details of an error, as defined by RFC 7807 and served as `application/problem+json`. The `code`,
+// `field` and `message` extension members are kept from the first version of the errors.
 type ErrorResponses struct {
-	Code    int    `json:"code,omitempty"`
-	Field   string `json:"field,omitempty"`
-	Message string `json:"message,omitempty"`
+	Code int `json:"code,omitempty"`
+
+	// Detail Explanation of this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	Field  string `json:"field,omitempty"`
+
+	// InvalidParams Request parameters or body fields that are invalid.
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+	Message       string         `json:"message,omitempty"`
+
+	// RequestId ID of the request, to find it in the server logs.
+	RequestId string `json:"request_id,omitempty"`
+
+	// Status HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type.
+	Title string `json:"title"`
+
+	// Type URI reference identifying the problem type, e.g. `/tax-calculator/problems/invalid-request`.
+	Type string `json:"type"`
 }
 
 // HealthCheckResponses defines model for HealthCheckResponses.
@@ -50,46 +396,457 @@ type HealthCheckResponses struct {
 	Status string `json:"status"`
 }
 
+// IncomeItem An income item converted to the currency of the jurisdiction.
+type IncomeItem struct {
+	Amount          float64 `json:"amount"`
+	ConvertedAmount float64 `json:"converted_amount"`
+	Currency        string  `json:"currency"`
+	Description     string  `json:"description,omitempty"`
+
+	// ExchangeRate Annual-average exchange rate of the tax year used to convert the amount.
+	ExchangeRate float64 `json:"exchange_rate"`
+}
+
+// IncomeItemInput defines model for IncomeItemInput.
+type IncomeItemInput struct {
+	Amount float64 `json:"amount"`
+
+	// Currency ISO 4217 currency code, e.g. `USD` or `EUR`. Defaults to the currency of the jurisdiction.
+	Currency    string `json:"currency,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	// Name Name of the parameter or path of the body field, e.g. `year` or `income_items[0].currency`.
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// RRSPBracketThreshold defines model for RRSPBracketThreshold.
+type RRSPBracketThreshold struct {
+	Contribution float64 `json:"contribution"`
+
+	// Rate Rate of the bracket the taxable income drops to.
+	Rate float64 `json:"rate"`
+
+	// TaxSavings Total tax owed without a contribution less the total tax owed with the contribution.
+	TaxSavings float64 `json:"tax_savings"`
+
+	// TaxSavingsRate Tax savings as a fraction of the contribution.
+	TaxSavingsRate float64 `json:"tax_savings_rate"`
+	TaxableIncome  float64 `json:"taxable_income"`
+	Threshold      float64 `json:"threshold"`
+	TotalTaxOwed   float64 `json:"total_tax_owed"`
+}
+
+// RRSPContribution defines model for RRSPContribution.
+type RRSPContribution struct {
+	Contribution float64 `json:"contribution"`
+
+	// TaxSavings Total tax owed without a contribution less the total tax owed with the contribution.
+	TaxSavings float64 `json:"tax_savings"`
+
+	// TaxSavingsRate Tax savings as a fraction of the contribution.
+	TaxSavingsRate float64 `json:"tax_savings_rate"`
+	TaxableIncome  float64 `json:"taxable_income"`
+	TotalTaxOwed   float64 `json:"total_tax_owed"`
+}
+
+// RRSPRequest defines model for RRSPRequest.
+type RRSPRequest struct {
+	// CanadianSourceIncome Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome float64 `json:"canadian_source_income,omitempty"`
+	ContributionRoom     float64 `json:"contribution_room"`
+
+	// Deductions Deductions, e.g. RRSP contributions, subtracted from the salary before the brackets are applied.
+	Deductions float64 `json:"deductions,omitempty"`
+
+	// FilingStatus US filing status, one of `single`, `married_filing_jointly`,
+	// `married_filing_separately` or `head_of_household`. Required for the `US` jurisdiction.
+	FilingStatus string `json:"filing_status,omitempty"`
+
+	// IncomeItems Other income items, e.g. foreign employment income, added to the salary. Items in another currency
+	// are converted with the annual-average exchange rate of the tax year.
+	IncomeItems []IncomeItemInput `json:"income_items,omitempty"`
+
+	// Increment Step between the contributions of the tax savings curve. Defaults to 1000.
+	Increment float64 `json:"increment,omitempty"`
+
+	// InstalmentsPaid Tax instalments already paid for the year.
+	InstalmentsPaid float64 `json:"instalments_paid,omitempty"`
+
+	// Jurisdiction Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+
+	// NonResident Set for non-residents of Canada, who are taxed on their Canadian-source income only.
+	NonResident bool `json:"non_resident,omitempty"`
+
+	// Province Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	// Provincial tax is added to the federal tax when a province is given.
+	Province string `json:"province,omitempty"`
+
+	// ResidencyEndDate Last day of residence in Canada, for emigrants.
+	ResidencyEndDate *openapi_types.Date `json:"residency_end_date,omitempty"`
+
+	// ResidencyStartDate First day of residence in Canada, for immigrants. The salary is the income earned while resident
+	// and the non-refundable credits are prorated by the days resident.
+	ResidencyStartDate *openapi_types.Date `json:"residency_start_date,omitempty"`
+	Salary             float64             `json:"salary"`
+
+	// TaxWithheld Income tax already withheld at source, e.g. from pay slips.
+	TaxWithheld float64 `json:"tax_withheld,omitempty"`
+}
+
+// RRSPResponse defines model for RRSPResponse.
+type RRSPResponse struct {
+	ContributionRoom float64            `json:"contribution_room"`
+	Curve            []RRSPContribution `json:"curve"`
+	Increment        float64            `json:"increment"`
+
+	// NextLowerBracket The contribution that brings the taxable income down to the threshold of the next lower bracket.
+	NextLowerBracket *RRSPBracketThreshold `json:"next_lower_bracket,omitempty"`
+	TaxYear          string                `json:"tax_year"`
+
+	// TaxableIncome Taxable income without a contribution.
+	TaxableIncome float64 `json:"taxable_income"`
+}
+
+// Residency Residency of part-year residents and non-residents, and the proration factors it implies.
+type Residency struct {
+	// CreditProrationFactor Factor the non-refundable credits are prorated by.
+	CreditProrationFactor float64 `json:"credit_proration_factor"`
+	DaysInYear            int     `json:"days_in_year"`
+	DaysResident          int     `json:"days_resident"`
+
+	// IncomeProrationFactor Share of the salary that is taxed.
+	IncomeProrationFactor float64 `json:"income_proration_factor"`
+
+	// Status Either `part_year_resident` or `non_resident`.
+	Status string `json:"status"`
+}
+
+// RoundingPolicy How the amounts of the calculation are rounded, as set for the jurisdiction in the dataset.
+type RoundingPolicy struct {
+	// EffectiveRateDecimals Number of decimals of the effective tax rate.
+	EffectiveRateDecimals int `json:"effective_rate_decimals"`
+
+	// Method Either `half_up` or `half_even` (banker's rounding).
+	Method string `json:"method"`
+
+	// Mode Either `per_line`, rounding every band and credit, or `total`, rounding only the totals.
+	Mode string `json:"mode"`
+
+	// Precision Either `cents` or `dollars`.
+	Precision string `json:"precision"`
+}
+
+// ScenarioDelta Difference between a scenario and the baseline scenario.
+type ScenarioDelta struct {
+	// EffectiveTaxRate Difference in percentage points.
+	EffectiveTaxRate float64 `json:"effective_tax_rate"`
+	NetIncome        float64 `json:"net_income"`
+	TotalTaxOwed     float64 `json:"total_tax_owed"`
+}
+
+// ScenarioInput defines model for ScenarioInput.
+type ScenarioInput struct {
+	// CanadianSourceIncome Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome float64 `json:"canadian_source_income,omitempty"`
+
+	// Deductions Deductions, e.g. RRSP contributions, subtracted from the salary before the brackets are applied.
+	Deductions float64 `json:"deductions,omitempty"`
+
+	// FilingStatus US filing status, one of `single`, `married_filing_jointly`,
+	// `married_filing_separately` or `head_of_household`. Required for the `US` jurisdiction.
+	FilingStatus string `json:"filing_status,omitempty"`
+
+	// IncomeItems Other income items, e.g. foreign employment income, added to the salary. Items in another currency
+	// are converted with the annual-average exchange rate of the tax year.
+	IncomeItems []IncomeItemInput `json:"income_items,omitempty"`
+
+	// InstalmentsPaid Tax instalments already paid for the year.
+	InstalmentsPaid float64 `json:"instalments_paid,omitempty"`
+
+	// Jurisdiction Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+	Name         string `json:"name"`
+
+	// NonResident Set for non-residents of Canada, who are taxed on their Canadian-source income only.
+	NonResident bool `json:"non_resident,omitempty"`
+
+	// Province Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	// Provincial tax is added to the federal tax when a province is given.
+	Province string `json:"province,omitempty"`
+
+	// ResidencyEndDate Last day of residence in Canada, for emigrants.
+	ResidencyEndDate *openapi_types.Date `json:"residency_end_date,omitempty"`
+
+	// ResidencyStartDate First day of residence in Canada, for immigrants. The salary is the income earned while resident
+	// and the non-refundable credits are prorated by the days resident.
+	ResidencyStartDate *openapi_types.Date `json:"residency_start_date,omitempty"`
+	Salary             float64             `json:"salary"`
+
+	// TaxWithheld Income tax already withheld at source, e.g. from pay slips.
+	TaxWithheld float64 `json:"tax_withheld,omitempty"`
+
+	// Year Tax year, or one of the symbolic years `latest`, `current` or `previous`.
+	Year string `json:"year"`
+}
+
+// ScenarioResult defines model for ScenarioResult.
+type ScenarioResult struct {
+	// Delta Difference between a scenario and the baseline scenario.
+	Delta  ScenarioDelta     `json:"delta"`
+	Name   string            `json:"name"`
+	Result CalculateResponse `json:"result"`
+}
+
 // TaxBracket defines model for TaxBracket.
 type TaxBracket struct {
-	Max     float32 `json:"max"`
-	Min     float32 `json:"min"`
-	Rate    float32 `json:"rate"`
-	TaxOwed float32 `json:"tax_owed,omitempty"`
+	Max     float64 `json:"max"`
+	Min     float64 `json:"min"`
+	Rate    float64 `json:"rate"`
+	TaxOwed float64 `json:"tax_owed,omitempty"`
 }
 
 // TaxBracketResponses defines model for TaxBracketResponses.
 type TaxBracketResponses = []TaxBracket
 
+// TaxBracketV2 defines model for TaxBracketV2.
+type TaxBracketV2 struct {
+	// Max Upper limit of the bracket, or null for the open-ended top bracket.
+	Max *float64 `json:"max"`
+	Min float64  `json:"min"`
+
+	// Rate Marginal rate of the bracket as a fraction, e.g. 0.205.
+	Rate float64 `json:"rate"`
+}
+
+// TaxYearProvenance Where the federal tax bracket of a tax year comes from.
+type TaxYearProvenance struct {
+	// DatasetVersion Hash of the dataset the tax bracket is loaded from.
+	DatasetVersion string `json:"dataset_version"`
+
+	// LastVerified When the tax bracket was last checked against the source document.
+	LastVerified *openapi_types.Date `json:"last_verified,omitempty"`
+	Notes        string              `json:"notes,omitempty"`
+
+	// PublicationDate When the source document was published, when known.
+	PublicationDate *openapi_types.Date `json:"publication_date,omitempty"`
+
+	// Source Name of the authoritative source document.
+	Source    string `json:"source"`
+	SourceUrl string `json:"source_url"`
+	Year      string `json:"year"`
+}
+
+// TaxYearResponse The tax bracket of a tax year, with where it comes from and the version of the dataset.
+type TaxYearResponse struct {
+	Brackets       TaxBracketResponses `json:"brackets"`
+	DatasetVersion string              `json:"dataset_version"`
+
+	// Provenance Where the federal tax bracket of a tax year comes from.
+	Provenance TaxYearProvenance `json:"provenance"`
+	Year       string            `json:"year"`
+}
+
+// TaxYearV2 defines model for TaxYearV2.
+type TaxYearV2 struct {
+	Brackets []TaxBracketV2 `json:"brackets"`
+
+	// Currency ISO 4217 currency code of the amounts.
+	Currency       string `json:"currency"`
+	DatasetVersion string `json:"dataset_version"`
+	Jurisdiction   string `json:"jurisdiction"`
+
+	// Provenance Where the federal tax bracket of a tax year comes from.
+	Provenance *TaxYearProvenance `json:"provenance,omitempty"`
+	Year       string             `json:"year"`
+}
+
+// TaxYearsV2 defines model for TaxYearsV2.
+type TaxYearsV2 struct {
+	TaxYears []TaxYearV2 `json:"tax_years"`
+}
+
+// IfModifiedSince defines model for If-Modified-Since.
+type IfModifiedSince = string
+
+// IfNoneMatch defines model for If-None-Match.
+type IfNoneMatch = string
+
+// JobId defines model for JobId.
+type JobId = string
+
+// GetTaxCalculatorParams defines parameters for GetTaxCalculator.
+type GetTaxCalculatorParams struct {
+	// IfNoneMatch ETags of the representations the client has; a 304 is returned when one of them is current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of the representation the client has; without If-None-Match, a 304 is returned when the dataset has
+	// not been modified since.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetAllTaxCalculatorParams defines parameters for GetAllTaxCalculator.
+type GetAllTaxCalculatorParams struct {
+	// IfNoneMatch ETags of the representations the client has; a 304 is returned when one of them is current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of the representation the client has; without If-None-Match, a 304 is returned when the dataset has
+	// not been modified since.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetTaxCalculatorByYearParams defines parameters for GetTaxCalculatorByYear.
+type GetTaxCalculatorByYearParams struct {
+	// IfNoneMatch ETags of the representations the client has; a 304 is returned when one of them is current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of the representation the client has; without If-None-Match, a 304 is returned when the dataset has
+	// not been modified since.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetCalculationParams defines parameters for GetCalculation.
+type GetCalculationParams struct {
+	Salary float64 `form:"salary" json:"salary"`
+
+	// Jurisdiction Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction *string `form:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+
+	// FilingStatus US filing status. Required for the `US` jurisdiction.
+	FilingStatus *string `form:"filing_status,omitempty" json:"filing_status,omitempty"`
+
+	// Province Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	Province *string `form:"province,omitempty" json:"province,omitempty"`
+
+	// Deductions Deductions subtracted from the salary before the brackets are applied.
+	Deductions *float64 `form:"deductions,omitempty" json:"deductions,omitempty"`
+
+	// TaxWithheld Income tax already withheld at source.
+	TaxWithheld *float64 `form:"tax_withheld,omitempty" json:"tax_withheld,omitempty"`
+
+	// InstalmentsPaid Tax instalments already paid for the year.
+	InstalmentsPaid *float64 `form:"instalments_paid,omitempty" json:"instalments_paid,omitempty"`
+
+	// ResidencyStartDate First day of residence in Canada, for immigrants.
+	ResidencyStartDate *openapi_types.Date `form:"residency_start_date,omitempty" json:"residency_start_date,omitempty"`
+
+	// ResidencyEndDate Last day of residence in Canada, for emigrants.
+	ResidencyEndDate *openapi_types.Date `form:"residency_end_date,omitempty" json:"residency_end_date,omitempty"`
+
+	// NonResident Set for non-residents of Canada.
+	NonResident *bool `form:"non_resident,omitempty" json:"non_resident,omitempty"`
+
+	// CanadianSourceIncome Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome *float64 `form:"canadian_source_income,omitempty" json:"canadian_source_income,omitempty"`
+}
+
+// GetTaxYearsV2Params defines parameters for GetTaxYearsV2.
+type GetTaxYearsV2Params struct {
+	// IfNoneMatch ETags of the representations the client has; a 304 is returned when one of them is current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of the representation the client has; without If-None-Match, a 304 is returned when the dataset has
+	// not been modified since.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetTaxYearV2Params defines parameters for GetTaxYearV2.
+type GetTaxYearV2Params struct {
+	// IfNoneMatch ETags of the representations the client has; a 304 is returned when one of them is current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of the representation the client has; without If-None-Match, a 304 is returned when the dataset has
+	// not been modified since.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// CreateCalculationJobJSONRequestBody defines body for CreateCalculationJob for application/json ContentType.
+type CreateCalculationJobJSONRequestBody = CalculationJobRequest
+
+// CompareScenariosJSONRequestBody defines body for CompareScenarios for application/json ContentType.
+type CompareScenariosJSONRequestBody = CompareScenariosRequest
+
+// CalculateBonusJSONRequestBody defines body for CalculateBonus for application/json ContentType.
+type CalculateBonusJSONRequestBody = BonusRequest
+
 // CalculateJSONRequestBody defines body for Calculate for application/json ContentType.
 type CalculateJSONRequestBody = CalculateRequest
 
+// OptimizeRRSPJSONRequestBody defines body for OptimizeRRSP for application/json ContentType.
+type OptimizeRRSPJSONRequestBody = RRSPRequest
+
+// CalculateV2JSONRequestBody defines body for CalculateV2 for application/json ContentType.
+type CalculateV2JSONRequestBody = CalculateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Get tax bracket for the default year 2022
+	// Get tax bracket for the latest year
 	// (GET /)
-	GetTaxCalculator(w http.ResponseWriter, r *http.Request)
+	GetTaxCalculator(w http.ResponseWriter, r *http.Request, params GetTaxCalculatorParams)
 	// Check
 	// (GET /health)
 	Check(w http.ResponseWriter, r *http.Request)
+	// Submit a calculation job
+	// (POST /jobs)
+	CreateCalculationJob(w http.ResponseWriter, r *http.Request)
+	// Cancel a calculation job
+	// (DELETE /jobs/{id})
+	CancelCalculationJob(w http.ResponseWriter, r *http.Request, id JobId)
+	// Get a calculation job
+	// (GET /jobs/{id})
+	GetCalculationJob(w http.ResponseWriter, r *http.Request, id JobId)
+	// Download the results of a calculation job
+	// (GET /jobs/{id}/results)
+	GetCalculationJobResults(w http.ResponseWriter, r *http.Request, id JobId)
+	// Compare scenarios
+	// (POST /scenarios/compare)
+	CompareScenarios(w http.ResponseWriter, r *http.Request)
 	// Get all tax brackets
 	// (GET /tax-years)
-	GetAllTaxCalculator(w http.ResponseWriter, r *http.Request)
+	GetAllTaxCalculator(w http.ResponseWriter, r *http.Request, params GetAllTaxCalculatorParams)
 	// Get tax bracket for the given year
 	// (GET /tax-years/{year})
-	GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request, year string)
+	GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request, year string, params GetTaxCalculatorByYearParams)
+	// Calculate bonus withholding
+	// (POST /tax-years/{year}/bonus)
+	CalculateBonus(w http.ResponseWriter, r *http.Request, year string)
+	// Calculate from query parameters
+	// (GET /tax-years/{year}/calculate)
+	GetCalculation(w http.ResponseWriter, r *http.Request, year string, params GetCalculationParams)
 	// Calculate
 	// (POST /tax-years/{year}/calculate)
 	Calculate(w http.ResponseWriter, r *http.Request, year string)
+	// Submit a calculation job as a stream of calculations
+	// (POST /tax-years/{year}/jobs)
+	CreateCalculationJobStream(w http.ResponseWriter, r *http.Request, year string)
+	// Get the provenance of the tax bracket for the given year
+	// (GET /tax-years/{year}/provenance)
+	GetTaxYearProvenance(w http.ResponseWriter, r *http.Request, year string)
+	// Optimize RRSP contribution
+	// (POST /tax-years/{year}/rrsp)
+	OptimizeRRSP(w http.ResponseWriter, r *http.Request, year string)
+	// Get the tax brackets of all years
+	// (GET /v2/tax-years)
+	GetTaxYearsV2(w http.ResponseWriter, r *http.Request, params GetTaxYearsV2Params)
+	// Get the tax brackets of the given year
+	// (GET /v2/tax-years/{year})
+	GetTaxYearV2(w http.ResponseWriter, r *http.Request, year string, params GetTaxYearV2Params)
+	// Calculate
+	// (POST /v2/tax-years/{year}/calculate)
+	CalculateV2(w http.ResponseWriter, r *http.Request, year string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
-// Get tax bracket for the default year 2022
+// Get tax bracket for the latest year
 // (GET /)
-func (_ Unimplemented) GetTaxCalculator(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetTaxCalculator(w http.ResponseWriter, r *http.Request, params GetTaxCalculatorParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -99,15 +856,57 @@ func (_ Unimplemented) Check(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Submit a calculation job
+// (POST /jobs)
+func (_ Unimplemented) CreateCalculationJob(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a calculation job
+// (DELETE /jobs/{id})
+func (_ Unimplemented) CancelCalculationJob(w http.ResponseWriter, r *http.Request, id JobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a calculation job
+// (GET /jobs/{id})
+func (_ Unimplemented) GetCalculationJob(w http.ResponseWriter, r *http.Request, id JobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download the results of a calculation job
+// (GET /jobs/{id}/results)
+func (_ Unimplemented) GetCalculationJobResults(w http.ResponseWriter, r *http.Request, id JobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Compare scenarios
+// (POST /scenarios/compare)
+func (_ Unimplemented) CompareScenarios(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get all tax brackets
 // (GET /tax-years)
-func (_ Unimplemented) GetAllTaxCalculator(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetAllTaxCalculator(w http.ResponseWriter, r *http.Request, params GetAllTaxCalculatorParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Get tax bracket for the given year
 // (GET /tax-years/{year})
-func (_ Unimplemented) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request, year string) {
+func (_ Unimplemented) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request, year string, params GetTaxCalculatorByYearParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Calculate bonus withholding
+// (POST /tax-years/{year}/bonus)
+func (_ Unimplemented) CalculateBonus(w http.ResponseWriter, r *http.Request, year string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Calculate from query parameters
+// (GET /tax-years/{year}/calculate)
+func (_ Unimplemented) GetCalculation(w http.ResponseWriter, r *http.Request, year string, params GetCalculationParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -117,6 +916,42 @@ func (_ Unimplemented) Calculate(w http.ResponseWriter, r *http.Request, year st
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Submit a calculation job as a stream of calculations
+// (POST /tax-years/{year}/jobs)
+func (_ Unimplemented) CreateCalculationJobStream(w http.ResponseWriter, r *http.Request, year string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the provenance of the tax bracket for the given year
+// (GET /tax-years/{year}/provenance)
+func (_ Unimplemented) GetTaxYearProvenance(w http.ResponseWriter, r *http.Request, year string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Optimize RRSP contribution
+// (POST /tax-years/{year}/rrsp)
+func (_ Unimplemented) OptimizeRRSP(w http.ResponseWriter, r *http.Request, year string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the tax brackets of all years
+// (GET /v2/tax-years)
+func (_ Unimplemented) GetTaxYearsV2(w http.ResponseWriter, r *http.Request, params GetTaxYearsV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the tax brackets of the given year
+// (GET /v2/tax-years/{year})
+func (_ Unimplemented) GetTaxYearV2(w http.ResponseWriter, r *http.Request, year string, params GetTaxYearV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Calculate
+// (POST /v2/tax-years/{year}/calculate)
+func (_ Unimplemented) CalculateV2(w http.ResponseWriter, r *http.Request, year string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -130,8 +965,53 @@ type MiddlewareFunc func(http.Handler) http.Handler
 func (siw *ServerInterfaceWrapper) GetTaxCalculator(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaxCalculatorParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTaxCalculator(w, r)
+		siw.Handler.GetTaxCalculator(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -156,12 +1036,12 @@ func (siw *ServerInterfaceWrapper) Check(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetAllTaxCalculator operation middleware
-func (siw *ServerInterfaceWrapper) GetAllTaxCalculator(w http.ResponseWriter, r *http.Request) {
+// CreateCalculationJob operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalculationJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllTaxCalculator(w, r)
+		siw.Handler.CreateCalculationJob(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -171,23 +1051,23 @@ func (siw *ServerInterfaceWrapper) GetAllTaxCalculator(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetTaxCalculatorByYear operation middleware
-func (siw *ServerInterfaceWrapper) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request) {
+// CancelCalculationJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelCalculationJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "year" -------------
-	var year string
+	// ------------- Path parameter "id" -------------
+	var id JobId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTaxCalculatorByYear(w, r, year)
+		siw.Handler.CancelCalculationJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -197,23 +1077,602 @@ func (siw *ServerInterfaceWrapper) GetTaxCalculatorByYear(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Calculate operation middleware
-func (siw *ServerInterfaceWrapper) Calculate(w http.ResponseWriter, r *http.Request) {
+// GetCalculationJob operation middleware
+func (siw *ServerInterfaceWrapper) GetCalculationJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "year" -------------
-	var year string
+	// ------------- Path parameter "id" -------------
+	var id JobId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Calculate(w, r, year)
+		siw.Handler.GetCalculationJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCalculationJobResults operation middleware
+func (siw *ServerInterfaceWrapper) GetCalculationJobResults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id JobId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalculationJobResults(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CompareScenarios operation middleware
+func (siw *ServerInterfaceWrapper) CompareScenarios(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompareScenarios(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAllTaxCalculator operation middleware
+func (siw *ServerInterfaceWrapper) GetAllTaxCalculator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllTaxCalculatorParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllTaxCalculator(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTaxCalculatorByYear operation middleware
+func (siw *ServerInterfaceWrapper) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaxCalculatorByYearParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaxCalculatorByYear(w, r, year, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CalculateBonus operation middleware
+func (siw *ServerInterfaceWrapper) CalculateBonus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CalculateBonus(w, r, year)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCalculation operation middleware
+func (siw *ServerInterfaceWrapper) GetCalculation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalculationParams
+
+	// ------------- Required query parameter "salary" -------------
+
+	if paramValue := r.URL.Query().Get("salary"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "salary"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "salary", r.URL.Query(), &params.Salary)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "salary", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "jurisdiction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "jurisdiction", r.URL.Query(), &params.Jurisdiction)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jurisdiction", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filing_status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filing_status", r.URL.Query(), &params.FilingStatus)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filing_status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "province" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "province", r.URL.Query(), &params.Province)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "province", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deductions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deductions", r.URL.Query(), &params.Deductions)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deductions", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tax_withheld" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tax_withheld", r.URL.Query(), &params.TaxWithheld)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tax_withheld", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "instalments_paid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "instalments_paid", r.URL.Query(), &params.InstalmentsPaid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "instalments_paid", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "residency_start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "residency_start_date", r.URL.Query(), &params.ResidencyStartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "residency_start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "residency_end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "residency_end_date", r.URL.Query(), &params.ResidencyEndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "residency_end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "non_resident" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "non_resident", r.URL.Query(), &params.NonResident)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "non_resident", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "canadian_source_income" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "canadian_source_income", r.URL.Query(), &params.CanadianSourceIncome)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "canadian_source_income", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalculation(w, r, year, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Calculate operation middleware
+func (siw *ServerInterfaceWrapper) Calculate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Calculate(w, r, year)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateCalculationJobStream operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalculationJobStream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCalculationJobStream(w, r, year)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTaxYearProvenance operation middleware
+func (siw *ServerInterfaceWrapper) GetTaxYearProvenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaxYearProvenance(w, r, year)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// OptimizeRRSP operation middleware
+func (siw *ServerInterfaceWrapper) OptimizeRRSP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OptimizeRRSP(w, r, year)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTaxYearsV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetTaxYearsV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaxYearsV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaxYearsV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTaxYearV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetTaxYearV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaxYearV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaxYearV2(w, r, year, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CalculateV2 operation middleware
+func (siw *ServerInterfaceWrapper) CalculateV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", chi.URLParam(r, "year"), &year, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CalculateV2(w, r, year)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -228,326 +1687,1887 @@ type UnescapedCookieParamError struct {
 	Err       error
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/", wrapper.GetTaxCalculator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.Check)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs", wrapper.CreateCalculationJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/jobs/{id}", wrapper.CancelCalculationJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs/{id}", wrapper.GetCalculationJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs/{id}/results", wrapper.GetCalculationJobResults)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/scenarios/compare", wrapper.CompareScenarios)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tax-years", wrapper.GetAllTaxCalculator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tax-years/{year}", wrapper.GetTaxCalculatorByYear)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tax-years/{year}/bonus", wrapper.CalculateBonus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tax-years/{year}/calculate", wrapper.GetCalculation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tax-years/{year}/calculate", wrapper.Calculate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tax-years/{year}/jobs", wrapper.CreateCalculationJobStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tax-years/{year}/provenance", wrapper.GetTaxYearProvenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tax-years/{year}/rrsp", wrapper.OptimizeRRSP)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tax-years", wrapper.GetTaxYearsV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tax-years/{year}", wrapper.GetTaxYearV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/tax-years/{year}/calculate", wrapper.CalculateV2)
+	})
+
+	return r
+}
+
+type GetTaxCalculatorRequestObject struct {
+	Params GetTaxCalculatorParams
+}
+
+type GetTaxCalculatorResponseObject interface {
+	VisitGetTaxCalculatorResponse(w http.ResponseWriter) error
+}
+
+type GetTaxCalculator200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+	XTaxYear     string
+}
+
+type GetTaxCalculator200JSONResponse struct {
+	Body    TaxBracketResponses
+	Headers GetTaxCalculator200ResponseHeaders
+}
+
+func (response GetTaxCalculator200JSONResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTaxCalculator200ApplicationxmlResponse struct {
+	Body          io.Reader
+	Headers       GetTaxCalculator200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaxCalculator200ApplicationxmlResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaxCalculator200ApplicationyamlResponse struct {
+	Body          io.Reader
+	Headers       GetTaxCalculator200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaxCalculator200ApplicationyamlResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaxCalculator200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetTaxCalculator200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaxCalculator200TextcsvResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaxCalculator304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetTaxCalculator304Response struct {
+	Headers GetTaxCalculator304ResponseHeaders
+}
+
+func (response GetTaxCalculator304Response) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetTaxCalculator400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxCalculator400ApplicationProblemPlusJSONResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckRequestObject struct {
+}
+
+type CheckResponseObject interface {
+	VisitCheckResponse(w http.ResponseWriter) error
+}
+
+type Check200JSONResponse HealthCheckResponses
+
+func (response Check200JSONResponse) VisitCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJobRequestObject struct {
+	Body *CreateCalculationJobJSONRequestBody
+}
+
+type CreateCalculationJobResponseObject interface {
+	VisitCreateCalculationJobResponse(w http.ResponseWriter) error
+}
+
+type CreateCalculationJob202ResponseHeaders struct {
+	Location string
+}
+
+type CreateCalculationJob202JSONResponse struct {
+	Body    CalculationJob
+	Headers CreateCalculationJob202ResponseHeaders
+}
+
+func (response CreateCalculationJob202JSONResponse) VisitCreateCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCalculationJob400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJob400ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJob404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJob404ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJob413ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJob413ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJob503ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJob503ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelCalculationJobRequestObject struct {
+	Id JobId `json:"id"`
+}
+
+type CancelCalculationJobResponseObject interface {
+	VisitCancelCalculationJobResponse(w http.ResponseWriter) error
+}
+
+type CancelCalculationJob200JSONResponse CalculationJob
+
+func (response CancelCalculationJob200JSONResponse) VisitCancelCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelCalculationJob404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CancelCalculationJob404ApplicationProblemPlusJSONResponse) VisitCancelCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculationJobRequestObject struct {
+	Id JobId `json:"id"`
+}
+
+type GetCalculationJobResponseObject interface {
+	VisitGetCalculationJobResponse(w http.ResponseWriter) error
+}
+
+type GetCalculationJob200JSONResponse CalculationJob
+
+func (response GetCalculationJob200JSONResponse) VisitGetCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculationJob404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetCalculationJob404ApplicationProblemPlusJSONResponse) VisitGetCalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculationJobResultsRequestObject struct {
+	Id JobId `json:"id"`
+}
+
+type GetCalculationJobResultsResponseObject interface {
+	VisitGetCalculationJobResultsResponse(w http.ResponseWriter) error
+}
+
+type GetCalculationJobResults200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCalculationJobResults200ApplicationxNdjsonResponse) VisitGetCalculationJobResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalculationJobResults404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetCalculationJobResults404ApplicationProblemPlusJSONResponse) VisitGetCalculationJobResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculationJobResults409ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetCalculationJobResults409ApplicationProblemPlusJSONResponse) VisitGetCalculationJobResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareScenariosRequestObject struct {
+	Body *CompareScenariosJSONRequestBody
+}
+
+type CompareScenariosResponseObject interface {
+	VisitCompareScenariosResponse(w http.ResponseWriter) error
+}
+
+type CompareScenarios200JSONResponse CompareScenariosResponse
+
+func (response CompareScenarios200JSONResponse) VisitCompareScenariosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareScenarios400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CompareScenarios400ApplicationProblemPlusJSONResponse) VisitCompareScenariosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareScenarios404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CompareScenarios404ApplicationProblemPlusJSONResponse) VisitCompareScenariosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllTaxCalculatorRequestObject struct {
+	Params GetAllTaxCalculatorParams
+}
+
+type GetAllTaxCalculatorResponseObject interface {
+	VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error
+}
+
+type GetAllTaxCalculator200ResponseHeaders struct {
+	CacheControl    string
+	ETag            string
+	LastModified    string
+	Vary            string
+	XDatasetVersion string
+}
+
+type GetAllTaxCalculator200JSONResponse struct {
+	Body    AllTaxBracketResponses
+	Headers GetAllTaxCalculator200ResponseHeaders
+}
+
+func (response GetAllTaxCalculator200JSONResponse) VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAllTaxCalculator200ApplicationxmlResponse struct {
+	Body          io.Reader
+	Headers       GetAllTaxCalculator200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetAllTaxCalculator200ApplicationxmlResponse) VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAllTaxCalculator200ApplicationyamlResponse struct {
+	Body          io.Reader
+	Headers       GetAllTaxCalculator200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetAllTaxCalculator200ApplicationyamlResponse) VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAllTaxCalculator200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetAllTaxCalculator200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetAllTaxCalculator200TextcsvResponse) VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAllTaxCalculator304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetAllTaxCalculator304Response struct {
+	Headers GetAllTaxCalculator304ResponseHeaders
+}
+
+func (response GetAllTaxCalculator304Response) VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetTaxCalculatorByYearRequestObject struct {
+	Year   string `json:"year"`
+	Params GetTaxCalculatorByYearParams
+}
+
+type GetTaxCalculatorByYearResponseObject interface {
+	VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error
+}
+
+type GetTaxCalculatorByYear200ResponseHeaders struct {
+	CacheControl    string
+	ETag            string
+	LastModified    string
+	Link            string
+	Vary            string
+	XDatasetVersion string
+	XTaxYear        string
+}
+
+type GetTaxCalculatorByYear200JSONResponse struct {
+	Body    TaxYearResponse
+	Headers GetTaxCalculatorByYear200ResponseHeaders
+}
+
+func (response GetTaxCalculatorByYear200JSONResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTaxCalculatorByYear200ApplicationxmlResponse struct {
+	Body          io.Reader
+	Headers       GetTaxCalculatorByYear200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaxCalculatorByYear200ApplicationxmlResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaxCalculatorByYear200ApplicationyamlResponse struct {
+	Body          io.Reader
+	Headers       GetTaxCalculatorByYear200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaxCalculatorByYear200ApplicationyamlResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaxCalculatorByYear200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetTaxCalculatorByYear200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTaxCalculatorByYear200TextcsvResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaxCalculatorByYear304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetTaxCalculatorByYear304Response struct {
+	Headers GetTaxCalculatorByYear304ResponseHeaders
+}
+
+func (response GetTaxCalculatorByYear304Response) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetTaxCalculatorByYear400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxCalculatorByYear400ApplicationProblemPlusJSONResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaxCalculatorByYear404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxCalculatorByYear404ApplicationProblemPlusJSONResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateBonusRequestObject struct {
+	Year string `json:"year"`
+	Body *CalculateBonusJSONRequestBody
+}
+
+type CalculateBonusResponseObject interface {
+	VisitCalculateBonusResponse(w http.ResponseWriter) error
+}
+
+type CalculateBonus200JSONResponse BonusResponse
+
+func (response CalculateBonus200JSONResponse) VisitCalculateBonusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateBonus400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CalculateBonus400ApplicationProblemPlusJSONResponse) VisitCalculateBonusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateBonus404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CalculateBonus404ApplicationProblemPlusJSONResponse) VisitCalculateBonusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculationRequestObject struct {
+	Year   string `json:"year"`
+	Params GetCalculationParams
+}
+
+type GetCalculationResponseObject interface {
+	VisitGetCalculationResponse(w http.ResponseWriter) error
+}
+
+type GetCalculation200ResponseHeaders struct {
+	CacheControl    string
+	Vary            string
+	XDatasetVersion string
+	XTaxYear        string
+}
+
+type GetCalculation200JSONResponse struct {
+	Body    CalculateResponse
+	Headers GetCalculation200ResponseHeaders
+}
+
+func (response GetCalculation200JSONResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCalculation200ApplicationpdfResponse struct {
+	Body          io.Reader
+	Headers       GetCalculation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCalculation200ApplicationpdfResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalculation200ApplicationxmlResponse struct {
+	Body          io.Reader
+	Headers       GetCalculation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCalculation200ApplicationxmlResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalculation200ApplicationyamlResponse struct {
+	Body          io.Reader
+	Headers       GetCalculation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCalculation200ApplicationyamlResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalculation200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetCalculation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCalculation200TextcsvResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalculation400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetCalculation400ApplicationProblemPlusJSONResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculation404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetCalculation404ApplicationProblemPlusJSONResponse) VisitGetCalculationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateRequestObject struct {
+	Year string `json:"year"`
+	Body *CalculateJSONRequestBody
+}
+
+type CalculateResponseObject interface {
+	VisitCalculateResponse(w http.ResponseWriter) error
+}
+
+type Calculate200ResponseHeaders struct {
+	XTaxYear string
+}
+
+type Calculate200JSONResponse struct {
+	Body    CalculateResponse
+	Headers Calculate200ResponseHeaders
+}
+
+func (response Calculate200JSONResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Calculate200ApplicationpdfResponse struct {
+	Body          io.Reader
+	Headers       Calculate200ResponseHeaders
+	ContentLength int64
+}
+
+func (response Calculate200ApplicationpdfResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type Calculate200ApplicationxmlResponse struct {
+	Body          io.Reader
+	Headers       Calculate200ResponseHeaders
+	ContentLength int64
+}
+
+func (response Calculate200ApplicationxmlResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type Calculate200ApplicationyamlResponse struct {
+	Body          io.Reader
+	Headers       Calculate200ResponseHeaders
+	ContentLength int64
+}
+
+func (response Calculate200ApplicationyamlResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type Calculate200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       Calculate200ResponseHeaders
+	ContentLength int64
+}
+
+func (response Calculate200TextcsvResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type Calculate400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response Calculate400ApplicationProblemPlusJSONResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Calculate404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response Calculate404ApplicationProblemPlusJSONResponse) VisitCalculateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJobStreamRequestObject struct {
+	Year string `json:"year"`
+	Body io.Reader
+}
+
+type CreateCalculationJobStreamResponseObject interface {
+	VisitCreateCalculationJobStreamResponse(w http.ResponseWriter) error
+}
+
+type CreateCalculationJobStream202ResponseHeaders struct {
+	Location string
+}
+
+type CreateCalculationJobStream202JSONResponse struct {
+	Body    CalculationJob
+	Headers CreateCalculationJobStream202ResponseHeaders
+}
+
+func (response CreateCalculationJobStream202JSONResponse) VisitCreateCalculationJobStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCalculationJobStream400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJobStream400ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJobStream404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJobStream404ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJobStream413ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJobStream413ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalculationJobStream503ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CreateCalculationJobStream503ApplicationProblemPlusJSONResponse) VisitCreateCalculationJobStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaxYearProvenanceRequestObject struct {
+	Year string `json:"year"`
+}
+
+type GetTaxYearProvenanceResponseObject interface {
+	VisitGetTaxYearProvenanceResponse(w http.ResponseWriter) error
+}
+
+type GetTaxYearProvenance200ResponseHeaders struct {
+	XTaxYear string
+}
+
+type GetTaxYearProvenance200JSONResponse struct {
+	Body    TaxYearProvenance
+	Headers GetTaxYearProvenance200ResponseHeaders
+}
+
+func (response GetTaxYearProvenance200JSONResponse) VisitGetTaxYearProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTaxYearProvenance400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxYearProvenance400ApplicationProblemPlusJSONResponse) VisitGetTaxYearProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaxYearProvenance404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxYearProvenance404ApplicationProblemPlusJSONResponse) VisitGetTaxYearProvenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OptimizeRRSPRequestObject struct {
+	Year string `json:"year"`
+	Body *OptimizeRRSPJSONRequestBody
+}
+
+type OptimizeRRSPResponseObject interface {
+	VisitOptimizeRRSPResponse(w http.ResponseWriter) error
+}
+
+type OptimizeRRSP200JSONResponse RRSPResponse
+
+func (response OptimizeRRSP200JSONResponse) VisitOptimizeRRSPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OptimizeRRSP400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response OptimizeRRSP400ApplicationProblemPlusJSONResponse) VisitOptimizeRRSPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OptimizeRRSP404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response OptimizeRRSP404ApplicationProblemPlusJSONResponse) VisitOptimizeRRSPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaxYearsV2RequestObject struct {
+	Params GetTaxYearsV2Params
+}
+
+type GetTaxYearsV2ResponseObject interface {
+	VisitGetTaxYearsV2Response(w http.ResponseWriter) error
+}
+
+type GetTaxYearsV2200ResponseHeaders struct {
+	CacheControl    string
+	ETag            string
+	LastModified    string
+	XDatasetVersion string
+}
+
+type GetTaxYearsV2200JSONResponse struct {
+	Body    TaxYearsV2
+	Headers GetTaxYearsV2200ResponseHeaders
+}
+
+func (response GetTaxYearsV2200JSONResponse) VisitGetTaxYearsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTaxYearsV2304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+}
+
+type GetTaxYearsV2304Response struct {
+	Headers GetTaxYearsV2304ResponseHeaders
+}
+
+func (response GetTaxYearsV2304Response) VisitGetTaxYearsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetTaxYearV2RequestObject struct {
+	Year   string `json:"year"`
+	Params GetTaxYearV2Params
+}
+
+type GetTaxYearV2ResponseObject interface {
+	VisitGetTaxYearV2Response(w http.ResponseWriter) error
+}
+
+type GetTaxYearV2200ResponseHeaders struct {
+	CacheControl    string
+	ETag            string
+	LastModified    string
+	XDatasetVersion string
+	XTaxYear        string
+}
+
+type GetTaxYearV2200JSONResponse struct {
+	Body    TaxYearV2
+	Headers GetTaxYearV2200ResponseHeaders
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+func (response GetTaxYearV2200JSONResponse) VisitGetTaxYearV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("X-Dataset-Version", fmt.Sprint(response.Headers.XDatasetVersion))
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type GetTaxYearV2304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetTaxYearV2304Response struct {
+	Headers GetTaxYearV2304ResponseHeaders
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+func (response GetTaxYearV2304Response) VisitGetTaxYearV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
 }
 
-type RequiredParamError struct {
-	ParamName string
+type GetTaxYearV2400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxYearV2400ApplicationProblemPlusJSONResponse) VisitGetTaxYearV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type GetTaxYearV2404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response GetTaxYearV2404ApplicationProblemPlusJSONResponse) VisitGetTaxYearV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type CalculateV2RequestObject struct {
+	Year string `json:"year"`
+	Body *CalculateV2JSONRequestBody
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type CalculateV2ResponseObject interface {
+	VisitCalculateV2Response(w http.ResponseWriter) error
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type CalculateV2200ResponseHeaders struct {
+	XTaxYear string
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type CalculateV2200JSONResponse struct {
+	Body    CalculateResponseV2
+	Headers CalculateV2200ResponseHeaders
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+func (response CalculateV2200JSONResponse) VisitCalculateV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Tax-Year", fmt.Sprint(response.Headers.XTaxYear))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type CalculateV2400ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CalculateV2400ApplicationProblemPlusJSONResponse) VisitCalculateV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type CalculateV2404ApplicationProblemPlusJSONResponse ErrorResponses
+
+func (response CalculateV2404ApplicationProblemPlusJSONResponse) VisitCalculateV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get tax bracket for the latest year
+	// (GET /)
+	GetTaxCalculator(ctx context.Context, request GetTaxCalculatorRequestObject) (GetTaxCalculatorResponseObject, error)
+	// Check
+	// (GET /health)
+	Check(ctx context.Context, request CheckRequestObject) (CheckResponseObject, error)
+	// Submit a calculation job
+	// (POST /jobs)
+	CreateCalculationJob(ctx context.Context, request CreateCalculationJobRequestObject) (CreateCalculationJobResponseObject, error)
+	// Cancel a calculation job
+	// (DELETE /jobs/{id})
+	CancelCalculationJob(ctx context.Context, request CancelCalculationJobRequestObject) (CancelCalculationJobResponseObject, error)
+	// Get a calculation job
+	// (GET /jobs/{id})
+	GetCalculationJob(ctx context.Context, request GetCalculationJobRequestObject) (GetCalculationJobResponseObject, error)
+	// Download the results of a calculation job
+	// (GET /jobs/{id}/results)
+	GetCalculationJobResults(ctx context.Context, request GetCalculationJobResultsRequestObject) (GetCalculationJobResultsResponseObject, error)
+	// Compare scenarios
+	// (POST /scenarios/compare)
+	CompareScenarios(ctx context.Context, request CompareScenariosRequestObject) (CompareScenariosResponseObject, error)
+	// Get all tax brackets
+	// (GET /tax-years)
+	GetAllTaxCalculator(ctx context.Context, request GetAllTaxCalculatorRequestObject) (GetAllTaxCalculatorResponseObject, error)
+	// Get tax bracket for the given year
+	// (GET /tax-years/{year})
+	GetTaxCalculatorByYear(ctx context.Context, request GetTaxCalculatorByYearRequestObject) (GetTaxCalculatorByYearResponseObject, error)
+	// Calculate bonus withholding
+	// (POST /tax-years/{year}/bonus)
+	CalculateBonus(ctx context.Context, request CalculateBonusRequestObject) (CalculateBonusResponseObject, error)
+	// Calculate from query parameters
+	// (GET /tax-years/{year}/calculate)
+	GetCalculation(ctx context.Context, request GetCalculationRequestObject) (GetCalculationResponseObject, error)
+	// Calculate
+	// (POST /tax-years/{year}/calculate)
+	Calculate(ctx context.Context, request CalculateRequestObject) (CalculateResponseObject, error)
+	// Submit a calculation job as a stream of calculations
+	// (POST /tax-years/{year}/jobs)
+	CreateCalculationJobStream(ctx context.Context, request CreateCalculationJobStreamRequestObject) (CreateCalculationJobStreamResponseObject, error)
+	// Get the provenance of the tax bracket for the given year
+	// (GET /tax-years/{year}/provenance)
+	GetTaxYearProvenance(ctx context.Context, request GetTaxYearProvenanceRequestObject) (GetTaxYearProvenanceResponseObject, error)
+	// Optimize RRSP contribution
+	// (POST /tax-years/{year}/rrsp)
+	OptimizeRRSP(ctx context.Context, request OptimizeRRSPRequestObject) (OptimizeRRSPResponseObject, error)
+	// Get the tax brackets of all years
+	// (GET /v2/tax-years)
+	GetTaxYearsV2(ctx context.Context, request GetTaxYearsV2RequestObject) (GetTaxYearsV2ResponseObject, error)
+	// Get the tax brackets of the given year
+	// (GET /v2/tax-years/{year})
+	GetTaxYearV2(ctx context.Context, request GetTaxYearV2RequestObject) (GetTaxYearV2ResponseObject, error)
+	// Calculate
+	// (POST /v2/tax-years/{year}/calculate)
+	CalculateV2(ctx context.Context, request CalculateV2RequestObject) (CalculateV2ResponseObject, error)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetTaxCalculator operation middleware
+func (sh *strictHandler) GetTaxCalculator(w http.ResponseWriter, r *http.Request, params GetTaxCalculatorParams) {
+	var request GetTaxCalculatorRequestObject
 
-	if r == nil {
-		r = chi.NewRouter()
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaxCalculator(ctx, request.(GetTaxCalculatorRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaxCalculator")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTaxCalculatorResponseObject); ok {
+		if err := validResponse.VisitGetTaxCalculatorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+}
+
+// Check operation middleware
+func (sh *strictHandler) Check(w http.ResponseWriter, r *http.Request) {
+	var request CheckRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Check(ctx, request.(CheckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Check")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/", wrapper.GetTaxCalculator)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.Check)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/tax-years", wrapper.GetAllTaxCalculator)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/tax-years/{year}", wrapper.GetTaxCalculatorByYear)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/tax-years/{year}/calculate", wrapper.Calculate)
-	})
+	response, err := handler(r.Context(), w, r, request)
 
-	return r
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckResponseObject); ok {
+		if err := validResponse.VisitCheckResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTaxCalculatorRequestObject struct {
-}
+// CreateCalculationJob operation middleware
+func (sh *strictHandler) CreateCalculationJob(w http.ResponseWriter, r *http.Request) {
+	var request CreateCalculationJobRequestObject
 
-type GetTaxCalculatorResponseObject interface {
-	VisitGetTaxCalculatorResponse(w http.ResponseWriter) error
-}
+	var body CreateCalculationJobJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetTaxCalculator200JSONResponse TaxBracketResponses
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCalculationJob(ctx, request.(CreateCalculationJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCalculationJob")
+	}
 
-func (response GetTaxCalculator200JSONResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCalculationJobResponseObject); ok {
+		if err := validResponse.VisitCreateCalculationJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTaxCalculator400JSONResponse ErrorResponses
+// CancelCalculationJob operation middleware
+func (sh *strictHandler) CancelCalculationJob(w http.ResponseWriter, r *http.Request, id JobId) {
+	var request CancelCalculationJobRequestObject
 
-func (response GetTaxCalculator400JSONResponse) VisitGetTaxCalculatorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCalculationJob(ctx, request.(CancelCalculationJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCalculationJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelCalculationJobResponseObject); ok {
+		if err := validResponse.VisitCancelCalculationJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CheckRequestObject struct {
+// GetCalculationJob operation middleware
+func (sh *strictHandler) GetCalculationJob(w http.ResponseWriter, r *http.Request, id JobId) {
+	var request GetCalculationJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalculationJob(ctx, request.(GetCalculationJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalculationJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalculationJobResponseObject); ok {
+		if err := validResponse.VisitGetCalculationJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CheckResponseObject interface {
-	VisitCheckResponse(w http.ResponseWriter) error
+// GetCalculationJobResults operation middleware
+func (sh *strictHandler) GetCalculationJobResults(w http.ResponseWriter, r *http.Request, id JobId) {
+	var request GetCalculationJobResultsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalculationJobResults(ctx, request.(GetCalculationJobResultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalculationJobResults")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalculationJobResultsResponseObject); ok {
+		if err := validResponse.VisitGetCalculationJobResultsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Check200JSONResponse HealthCheckResponses
+// CompareScenarios operation middleware
+func (sh *strictHandler) CompareScenarios(w http.ResponseWriter, r *http.Request) {
+	var request CompareScenariosRequestObject
+
+	var body CompareScenariosJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CompareScenarios(ctx, request.(CompareScenariosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompareScenarios")
+	}
 
-func (response Check200JSONResponse) VisitCheckResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompareScenariosResponseObject); ok {
+		if err := validResponse.VisitCompareScenariosResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllTaxCalculatorRequestObject struct {
-}
+// GetAllTaxCalculator operation middleware
+func (sh *strictHandler) GetAllTaxCalculator(w http.ResponseWriter, r *http.Request, params GetAllTaxCalculatorParams) {
+	var request GetAllTaxCalculatorRequestObject
 
-type GetAllTaxCalculatorResponseObject interface {
-	VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetAllTaxCalculator200JSONResponse AllTaxBracketResponses
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllTaxCalculator(ctx, request.(GetAllTaxCalculatorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllTaxCalculator")
+	}
 
-func (response GetAllTaxCalculator200JSONResponse) VisitGetAllTaxCalculatorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllTaxCalculatorResponseObject); ok {
+		if err := validResponse.VisitGetAllTaxCalculatorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTaxCalculatorByYearRequestObject struct {
-	Year string `json:"year"`
-}
+// GetTaxCalculatorByYear operation middleware
+func (sh *strictHandler) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request, year string, params GetTaxCalculatorByYearParams) {
+	var request GetTaxCalculatorByYearRequestObject
 
-type GetTaxCalculatorByYearResponseObject interface {
-	VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error
-}
+	request.Year = year
+	request.Params = params
 
-type GetTaxCalculatorByYear200JSONResponse TaxBracketResponses
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaxCalculatorByYear(ctx, request.(GetTaxCalculatorByYearRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaxCalculatorByYear")
+	}
 
-func (response GetTaxCalculatorByYear200JSONResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTaxCalculatorByYearResponseObject); ok {
+		if err := validResponse.VisitGetTaxCalculatorByYearResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTaxCalculatorByYear400JSONResponse ErrorResponses
+// CalculateBonus operation middleware
+func (sh *strictHandler) CalculateBonus(w http.ResponseWriter, r *http.Request, year string) {
+	var request CalculateBonusRequestObject
 
-func (response GetTaxCalculatorByYear400JSONResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Year = year
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CalculateBonusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetTaxCalculatorByYear404JSONResponse ErrorResponses
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateBonus(ctx, request.(CalculateBonusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateBonus")
+	}
 
-func (response GetTaxCalculatorByYear404JSONResponse) VisitGetTaxCalculatorByYearResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateBonusResponseObject); ok {
+		if err := validResponse.VisitCalculateBonusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateRequestObject struct {
-	Year string `json:"year"`
-	Body *CalculateJSONRequestBody
-}
+// GetCalculation operation middleware
+func (sh *strictHandler) GetCalculation(w http.ResponseWriter, r *http.Request, year string, params GetCalculationParams) {
+	var request GetCalculationRequestObject
 
-type CalculateResponseObject interface {
-	VisitCalculateResponse(w http.ResponseWriter) error
-}
+	request.Year = year
+	request.Params = params
 
-type Calculate200JSONResponse CalculateResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalculation(ctx, request.(GetCalculationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalculation")
+	}
 
-func (response Calculate200JSONResponse) VisitCalculateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalculationResponseObject); ok {
+		if err := validResponse.VisitGetCalculationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Calculate400JSONResponse ErrorResponses
+// Calculate operation middleware
+func (sh *strictHandler) Calculate(w http.ResponseWriter, r *http.Request, year string) {
+	var request CalculateRequestObject
 
-func (response Calculate400JSONResponse) VisitCalculateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Year = year
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CalculateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type Calculate404JSONResponse ErrorResponses
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Calculate(ctx, request.(CalculateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Calculate")
+	}
 
-func (response Calculate404JSONResponse) VisitCalculateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateResponseObject); ok {
+		if err := validResponse.VisitCalculateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get tax bracket for the default year 2022
-	// (GET /)
-	GetTaxCalculator(ctx context.Context, request GetTaxCalculatorRequestObject) (GetTaxCalculatorResponseObject, error)
-	// Check
-	// (GET /health)
-	Check(ctx context.Context, request CheckRequestObject) (CheckResponseObject, error)
-	// Get all tax brackets
-	// (GET /tax-years)
-	GetAllTaxCalculator(ctx context.Context, request GetAllTaxCalculatorRequestObject) (GetAllTaxCalculatorResponseObject, error)
-	// Get tax bracket for the given year
-	// (GET /tax-years/{year})
-	GetTaxCalculatorByYear(ctx context.Context, request GetTaxCalculatorByYearRequestObject) (GetTaxCalculatorByYearResponseObject, error)
-	// Calculate
-	// (POST /tax-years/{year}/calculate)
-	Calculate(ctx context.Context, request CalculateRequestObject) (CalculateResponseObject, error)
-}
+// CreateCalculationJobStream operation middleware
+func (sh *strictHandler) CreateCalculationJobStream(w http.ResponseWriter, r *http.Request, year string) {
+	var request CreateCalculationJobStreamRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Year = year
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Body = r.Body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCalculationJobStream(ctx, request.(CreateCalculationJobStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCalculationJobStream")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCalculationJobStreamResponseObject); ok {
+		if err := validResponse.VisitCreateCalculationJobStreamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetTaxCalculator operation middleware
-func (sh *strictHandler) GetTaxCalculator(w http.ResponseWriter, r *http.Request) {
-	var request GetTaxCalculatorRequestObject
+// GetTaxYearProvenance operation middleware
+func (sh *strictHandler) GetTaxYearProvenance(w http.ResponseWriter, r *http.Request, year string) {
+	var request GetTaxYearProvenanceRequestObject
+
+	request.Year = year
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaxCalculator(ctx, request.(GetTaxCalculatorRequestObject))
+		return sh.ssi.GetTaxYearProvenance(ctx, request.(GetTaxYearProvenanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaxCalculator")
+		handler = middleware(handler, "GetTaxYearProvenance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTaxCalculatorResponseObject); ok {
-		if err := validResponse.VisitGetTaxCalculatorResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTaxYearProvenanceResponseObject); ok {
+		if err := validResponse.VisitGetTaxYearProvenanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -555,23 +3575,32 @@ func (sh *strictHandler) GetTaxCalculator(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// Check operation middleware
-func (sh *strictHandler) Check(w http.ResponseWriter, r *http.Request) {
-	var request CheckRequestObject
+// OptimizeRRSP operation middleware
+func (sh *strictHandler) OptimizeRRSP(w http.ResponseWriter, r *http.Request, year string) {
+	var request OptimizeRRSPRequestObject
+
+	request.Year = year
+
+	var body OptimizeRRSPJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Check(ctx, request.(CheckRequestObject))
+		return sh.ssi.OptimizeRRSP(ctx, request.(OptimizeRRSPRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Check")
+		handler = middleware(handler, "OptimizeRRSP")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CheckResponseObject); ok {
-		if err := validResponse.VisitCheckResponse(w); err != nil {
+	} else if validResponse, ok := response.(OptimizeRRSPResponseObject); ok {
+		if err := validResponse.VisitOptimizeRRSPResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -579,23 +3608,25 @@ func (sh *strictHandler) Check(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetAllTaxCalculator operation middleware
-func (sh *strictHandler) GetAllTaxCalculator(w http.ResponseWriter, r *http.Request) {
-	var request GetAllTaxCalculatorRequestObject
+// GetTaxYearsV2 operation middleware
+func (sh *strictHandler) GetTaxYearsV2(w http.ResponseWriter, r *http.Request, params GetTaxYearsV2Params) {
+	var request GetTaxYearsV2RequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllTaxCalculator(ctx, request.(GetAllTaxCalculatorRequestObject))
+		return sh.ssi.GetTaxYearsV2(ctx, request.(GetTaxYearsV2RequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllTaxCalculator")
+		handler = middleware(handler, "GetTaxYearsV2")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllTaxCalculatorResponseObject); ok {
-		if err := validResponse.VisitGetAllTaxCalculatorResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTaxYearsV2ResponseObject); ok {
+		if err := validResponse.VisitGetTaxYearsV2Response(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -603,25 +3634,26 @@ func (sh *strictHandler) GetAllTaxCalculator(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetTaxCalculatorByYear operation middleware
-func (sh *strictHandler) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.Request, year string) {
-	var request GetTaxCalculatorByYearRequestObject
+// GetTaxYearV2 operation middleware
+func (sh *strictHandler) GetTaxYearV2(w http.ResponseWriter, r *http.Request, year string, params GetTaxYearV2Params) {
+	var request GetTaxYearV2RequestObject
 
 	request.Year = year
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaxCalculatorByYear(ctx, request.(GetTaxCalculatorByYearRequestObject))
+		return sh.ssi.GetTaxYearV2(ctx, request.(GetTaxYearV2RequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaxCalculatorByYear")
+		handler = middleware(handler, "GetTaxYearV2")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTaxCalculatorByYearResponseObject); ok {
-		if err := validResponse.VisitGetTaxCalculatorByYearResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTaxYearV2ResponseObject); ok {
+		if err := validResponse.VisitGetTaxYearV2Response(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -629,13 +3661,13 @@ func (sh *strictHandler) GetTaxCalculatorByYear(w http.ResponseWriter, r *http.R
 	}
 }
 
-// Calculate operation middleware
-func (sh *strictHandler) Calculate(w http.ResponseWriter, r *http.Request, year string) {
-	var request CalculateRequestObject
+// CalculateV2 operation middleware
+func (sh *strictHandler) CalculateV2(w http.ResponseWriter, r *http.Request, year string) {
+	var request CalculateV2RequestObject
 
 	request.Year = year
 
-	var body CalculateJSONRequestBody
+	var body CalculateV2JSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -643,18 +3675,18 @@ func (sh *strictHandler) Calculate(w http.ResponseWriter, r *http.Request, year
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Calculate(ctx, request.(CalculateRequestObject))
+		return sh.ssi.CalculateV2(ctx, request.(CalculateV2RequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Calculate")
+		handler = middleware(handler, "CalculateV2")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateResponseObject); ok {
-		if err := validResponse.VisitCalculateResponse(w); err != nil {
+	} else if validResponse, ok := response.(CalculateV2ResponseObject); ok {
+		if err := validResponse.VisitCalculateV2Response(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -665,23 +3697,134 @@ func (sh *strictHandler) Calculate(w http.ResponseWriter, r *http.Request, year
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RXT2/buBP9KsT8fkfZ8mZ70i0Jgm6A3cWizWURGMZYGktsJJIlKf9BoO++ICnbkqMU",
-	"TuVmu5fWociZN/PeDIfPkMpKSUHCGkiewaQFVeh/XpflA25vNKZPZD+RUVIY8l8wy7jlUmD5l5aKtOVh",
-	"/f+aVpDA/+Kjzbg1GA+ZaiKwO0WQgFx+odRCBNtJLiducWKeuJpIFfxMlOTCkobE6pqaCG6xTOsSLX2i",
-	"rzUZ69yrHhiDJeqd+9X6EHW1JH22jyYCTV9rrimD5HFvbn4RyCEBLzHTakWp5WtaWNwuNFrq4DdWc5G/",
-	"weHYDETgUMgNZQtFerFEkTlj3FL1Bro7LKPWuHuj+x2hHpMEKy2Wi30gF5PDidkO1qGsHbiIhigeoak7",
-	"raXu1WZfUKnMvIQqLnhVV5DMDr6cofxNalhxKrMxXFRkDOYjND2iYfxGWNritqD06Rv5MhZtbUYB7DWN",
-	"YG4EwZ06egG2wu2Y4q64GHP8pDt9d2+5WEm6eCKflBbcRdLeE8t7tT4XGhcr6VxlZFLN/T5I4KHg3DBu",
-	"GDLDK1USM6TXpNlKauZNrDltmCVjGRfso5xCBCVPqb1vBFYOzrXCtCB2NZ1BBLUuIYHCWmWSON5sNlP0",
-	"n6dS53F71sS/39/e/fn5buLOuLi4LZ2l+4PPB+fzc0DjXUMEa9ImAP9lOvPOfAIUpk++EUDObVEvp6ms",
-	"YomKT1zDyknEuhaWV+QPdD9MKp5lJW1QO0Ye4Y/DnzBvIpCKBCoOCfza+lNoC09Z7P7JQx25KkKX0fsM",
-	"EvhI9gG3++tZOgHqLutXs1nopcKS8OdRqZKn3kL8xUhxnJy+awxydJ/QjFu2DPs8s7YgltEK69Iyd8uw",
-	"q9nVlaPhwwWxnVwmQ7AKCu6dBsUaS56B22XqqvKjhssls+eBbyKIC9+VX2XGt+sfScfgrTAQeNjHUo+n",
-	"H/HtYS22uJ24AM23pBbG6vdR2ysj/ECA12XZ5c0M0IpDW44xx8/uv+bsKrvZ/R3GJYUaK7Lk0vZ42u/c",
-	"HmYly/u6AtcfIfHVDdG+q7Xz1/FScM006uSqf6k3zfznrPScr0n4UvlJStyB+PCeIAaS4kGlKIR0a7U4",
-	"t/N0kzkk2Djdv8v8hCXDW7KP5/B0Cx7QUMak6JgP4z1DkflFt6sV40lDO/g6U/dp1/MlVe9fzTcy212M",
-	"1hdP8qY/oe3nxh9VcC/f168oa59Tt/Lv1Vcrz1Y5x2qb/jfKratki7kfxo5rc+8ljKZB3mHI9NWXHu++",
-	"Zt78EwAA///rSBU5ghIAAA==",
+	"H4sIAAAAAAAC/+x9/XIbN/Lgq6B4V3W7dUNKUZLf/s5bW1ey7OwqldiKZOduK3KR4AxIwpoBJgBGEi+l",
+	"B7rnuBe76gYwn+CHxKEkO/pjNxZnBmg0uhv9jT8GscxyKZgwevDqj8GC0YQp/OcJjRdseCKFUTKFHxKm",
+	"Y8Vzw6UYvBp8WDCimM6l0IzEVJAp/CdesIRMl0QvqGKJ/UGTmVSEkoQuIyKV/UuQhSwUuVkwQcyCkSWj",
+	"inBN9DKbypTHo0sxiAY6XrCMwuRmmbPBq4E2iov54O4uGrz9QOddsH5lSnMpiJzhsIrlimkmDIXnEZkp",
+	"meGDhBqqmSHX9v0IfzT01gJCRYI/xFIYJgyB2Ucb4PmJajP8WSZ8xlkSwBfPWGPmG6pJSrUhmfsmIvDz",
+	"dIlv8SyXypBYZhkVyaa5f6VquWGLuCaCzaXh1LCE3HCzwHkmx3HMcjMhduc3TfS/h28s+EOH6O6s/6J6",
+	"4dHv12pakEwLnhrcjBE5NSReUDFnGqmBXTNVfksSyfRmmD7Q2+G/GVUBFPgdbUMwkyoiUsSM0JLm7Ju6",
+	"iBeEajJJqWHaTOBtxbRMr9mGfbiLBjlVNGPGsdDprCSI4QUXMQtg68OHM1gqC1Ms/hSnHIhwQfXfceNk",
+	"YcjpbPhOCjb8mZp4ERFKvj38zkJqCiVYUnGW34MF1ZdCSEOmjImS6IgGuCy3cQDI0sEgGgiaweq6a1i/",
+	"Gw3AuusFrtXhterOYlesSgqPrQwexoVSTJjRmhXUIFoP/Y9yehpg3tM3HuSYpnGR2r35LKflpDk1i2pK",
+	"ngyigWK/F1yBLDCqYBsIxz5EqjlOPhfaZEwY+CtXMmfKcIbPaCYL+/tMqoyawatBIotpCrviRhVFNsXl",
+	"3w7ncgg/DvUVz4cSF0PTYS65MExZsO48zG2otv3+rr7Q3+xgkYfzUwmVnH5msbkHVMdp+oHevlY0vmLm",
+	"3HGuxUGScPvRWQM3/1Wx2eDV4L8cVEfagUPrQWiou4cD95qK5Nej7vZk9LZLPB/znCmS8owbT0VTKhI8",
+	"C0WRpnggwq8yZ2LIRMISYmSOLwF9dXcavqLwT0dZzZ2/iwYZF/3SiKKG9TuiobdjecOS3kcFxIwrPmnu",
+	"xRlV5Sa4dwkXscSzmRoyo2mqCRflLoU34GHgtVgFNilCknH47YBfw1KHk4AKpSj0Ofu9YBqXStP0/Wzw",
+	"6rf1rHDiJBjzX95FbTKewsB9bkxr5Xb87pI+RUENZl6kqJOJgqZE05SqZUMOc5EXptTZcHCSU54QOD9l",
+	"DvvNzaiGMSsBuuzb+7ojO+QYttGzUOtkEbFiIOtpiuon1YSSmaKxqWmxOEiflBgNeDXvOJbCKD4t4FUd",
+	"4pmlkmlKGq8Rp5u47WgiP2XaHub55k/3tywTEsYfpMc0sNV2qzBrvukXfMHMuCTCJuCvmzCBHriQacLF",
+	"vF8Q7LL6l/VLp58/TMuIBrDgCjdbCjjH6O5zh68ubo9R3BIjS7QSaoiWhYpZZTLi5BHhIzbCv3mbdUXS",
+	"JPR+N8Zp/g/GQUsGl3tSbrkTVoMuF60TF03U1km4I/x20AY7h1ZHdsdU0IRTMbbbNran+noNwLE9nvxc",
+	"kxM3xNDtvB0iQv1MSDFUTPME0NzvxiYsKeIVsvdN+SwibDQfkfPzi7MmlUVEF1MDJwZLKmJ1S5uymVTW",
+	"7zC1+q8mVDFC8zzlLOl3ITOecjEfa0NNSIJ9vCD2DWLfiLwhN9FczFM2icgko0pxlozdUJ9hhnQ5iS5F",
+	"+5FmYGsbli4noEhPwOoby9l4IQvNgBwnI3Lu6L3UsCcfLybkc6G4Tjii1Rq+D5VJlkDG3LAssN73ZsGU",
+	"1y7xHbeHsCV8LgjL8lQugatKUqOJ1f7rJww5hW9BKaVC4pjW4o2XlwL2MpbimqmGV8fqSkN6zRSdM8Ju",
+	"rYeFqJqjwTu7nO3v17BOqpwilADOKahcNQuKKkWX98KcNjSFpesxqGphz03tLUJTxWiytIqd30+Ev1ca",
+	"rhNHGKj6GxFhHHdkcnI8IX9J2IwWqfkrEuTHi8loB9oSUoy9wOkCcsFMVyzBzqIMoxG5WUhkdENvS5WF",
+	"qxUijkiRLmvATqVMGRX3gDZX8jrs4DpzTwA4B2nMyg1EvDUx6oXC8WsQCK9P4P/fv7NM/svJZHQp3JDc",
+	"nbtcN9lmxhKm3DP0F1HiwYN35/ya7cj3fh3LMRPJOAkq9uAOBpd3c91clDsEKGAZnyvaOVGcMdj0EtWn",
+	"1YYqs2LiH7jaYmaelVOTD9V5wbVXbYAsGHVON54yP5S5FF5NtsQ3K0SCdnSsWMLd+ZIrqdDR7NzZCV3q",
+	"cgSL/I3L3ZcmivoKS5OgMQbLRmXOiRv/cqUQeiEOJ21Ol0SnPNd7dBM4NPSjPK20fGlKRczG8iaoILes",
+	"JiENWTKDsjgqnbMRYddMWJbjqFAdRvYv5ESqK2RKhd8CddRk/DZIvIsGDzFXndp+cnaGavrbU/S+/XJ2",
+	"FpFfzk79j8gZvxRsymLSUPa2Oh1rftuHH4yOibrLOrEPgsqeobeOKuEvtwAvBumUGlTdH3chLuwwvr5v",
+	"nKjh2WmEinaQ2E0duz95wmYzFht+zRounodC6bZsvB/faEc970vtvafu2LPauD/d78GqGzMrzU8rSe3T",
+	"lR4mf8AG3Wf96rugGTFBneq2IYQCMdaz6oOW3vdQdOWlOoeETwuzkIqbQED72D+y2Cm/c0cQxnV56gyh",
+	"UUeS40OnCFcfXwr3NXxEzuEMK8gvxf/7v1MWR6QyMytx6yWr/W43VbK1+P65PjDBOGdqDMGNrZm3Cp/t",
+	"wLxWVVzpfUOFgAswVoEt5CzAGY+vbJQ69yYUnZcvwleyEN7VuPYj996ZTLn9ch9KrzZUJFQl4/IU3E8o",
+	"74noqq3TPy+/tQ/pVQdCj/ABc6w8ai6sSZenhfbJTM5PVPdJ9XucWIj2Icna7uvmRFHdn92lx5qPO6Cx",
+	"9Wla/Xq0hXHVY3iRimR7HcylLexgnbRNrycxjB5xUudoDbgKLt6T746++VvpiyWxTErfqo3f650slq79",
+	"9GUYP2s9B8HwtqnpxM6OPRx9+83Rtz2HJx7XulqRpFP5t17MqPXjZFTNuaDpGuq6KDJPRKW7QyQNy4Aa",
+	"putpVzqU++PTfvqP5u/j2O/RZttIpDXz4ZHPmnVm4X3A3g+7V8ZMn2P+eWyNjSmML1r9vkZ+DO28UsYb",
+	"p0FNo6qp5I0FN9SVDqIDp3iY2aOuhRBQV0KHTENuew07IAgrlbStF7eO9hYtB47fUpxELVuhxt1djTCU",
+	"EnpSOc9/lNNAloxiEJYb01YOOTVsaHgWjMMxpWSgtOJ/Lawb7rOckhkFt9su6i67zbli2gHWnsmVMsy4",
+	"4BoqjGBOOOi5wahikbqwY8JSZtppLevWZgHvTvkOyR/0hlo4QpNECka0JDMKpUtSM5d7ykF5uKYphMPQ",
+	"jUgFQbS5VGKuHJg1FMH65/dUMe3y77V7VrvbwT0J3riHoWinxeZKzhXTgUjYDy3zYdX0pSlx9H2/ep2j",
+	"uHGhAnVxH89/qspb8D1XZ+R5ZUEhiBfHjCW7sQwmAdyTGlblZr13SRe/F6xgCaRcqEIILubwzxJa+MNy",
+	"zKSqL/L8F8sitaHhKSM3ihvDRESkuhSTGERaCp9ZZzkTRQbHhJ1tEA3cZINoUM41KJkzGpTfDz6113TP",
+	"w29rMnZb6Ep8HkzFy/VVaQEKXlGV1ipC66VWB08eRxEOVI+mOufXNqJkyqh+kvTgP7Pnlc0q67OSYRfp",
+	"d7e5PuG4Ea2WM0KBYqoz4PQNMRKOrfpRVQvVV3VlNheQsVH3FF+T8lqRzdZ2WQjjaGzfntrPvzk8PDzE",
+	"EiL/w4NNuAdRv6rV9zWZoKrJjMjEVf3ZvLBcsWsuCz3pjTccNzRQvAudyyynil3ETFDFpV65p1OqWcpF",
+	"wNfxjmYVWtw4+AfmhFr9J7bTJITOKRfajMgbm5Koy+Q4zA7z3+90/Pi1bE16fvUV0ZU0dvRAGmvtWgVT",
+	"r3u1Omuq2qzHR+M5SpPBXT+oK9cS9YPFt0pJ1ajb7OSFTlOWkYQZylM8cL3SHIGbOGEzLmwC4fkPJ+Rv",
+	"/3n4N1T5NVPX1pE8wST2GJnzILfD/ffPWoqJTWmcgEceU8dnnKXJBD+fZExrOmcTwm4NE9grIGPZ1HPQ",
+	"FctNLdKP3HLd7CmAMGqrybQEskyQFjIueAYqzuEOqoNFTKCC+jZPqaCVBsw1kbGzq0sJ4fCxC4Mj1nbL",
+	"E0KTaIxF8QEScEKQVEXzIMynMlkSnFvbygzYFjfUaPtMdXz/DEbewQfpiGUXJCi7yDFfW1bu3opq6oKv",
+	"QkWCVySVc72jtRDU/LEBgX3YCGH5Zgk7qb+GmzTktl9IZYgusgwC1E2KLXttPHSh9ruuWXZKFJsxyySY",
+	"D8RnSy7mnbmdejY5MPR26M9/qbyE0QeOFoduzyYN47JQfFjO05cygoN4bJZbuYNw/hejqVmcLFh81RDR",
+	"TXG2a6Zg+3DeGexa8KubRSTqaQ21ZAen+JRxWm/Z1auAOpJ8H30WSpDGexm9Fqp+eKC4htE/dvDl2XKj",
+	"FUG743sUJ5FC2y10yKtF1/eYcl+W39e81c1FBXYz5I5tF0w9Sj+P+yYteIH38eKNtabefjyfdC2HTQz0",
+	"pEQX3sDwltQ0g85++F4oq02vUlmxeYVmURXpe73FIxQI2GK0Hg/47fDTyONypwoxxajuEWeuc4sbNYQ6",
+	"qD116XofFoppqLLc3lsDX5/U4iQBb01YXpzX5IIrYg3F8BMlcyDWnhPa6gvdV7SsnMM1AtmyM0Y96mRV",
+	"5SkQQDDDIZE3wnNyOZ/HqmC3hqTyhimP4JHf78aOdT1Qraf9Bmk1vYblbEwq8j2xaBMjq7LryyrZ+ts9",
+	"U00F/aq8KHpL3BvhpKi9Arfn2PUjRJgblBcIEwfzQz1BBTZoB60U2GQfXXjqSxwrKbN+t6ps6RCwzwzL",
+	"yZSZG+ZiPK3+LZWO5kk4LtQ1a+oM4Efeo47Wxc62UnNl5yB6TTkmpXS7LBCYoRSKqz2Ce94zRPPWjsLA",
+	"ifvwjMEasfSZIXdrxnjwjN3Bs82aOjrI3pJ6OjK7fqSGj509knwzxb0p77qEV980Tzm7SLl6clrbj+ce",
+	"gWTIqTJDNN2quitgsEangqgqccNCceCwGY2NVBo9X1mectv4s5O+knAzLj8a248COQL4+z2q1Htu6UKX",
+	"esxFmyIf4ASGgeptIB48kjNBNqPuYgGoCXfIwW4S/aJqlV/yrWusAfSEeCzRYE2qen+M/sJ+ZTS8ifjW",
+	"jkYrCXE1noMGVTMls+ublTf1coJQD1LYK8wRg5I4yCxxjUHaFrr3Jrsksi5rVdlxwBLjhMU8o6lelzDh",
+	"3/FwlUOgagDD7OQ8zphZyGQ1ZSxoOhsXuWv/A39ASeCE/GVKxRVT/00Tnzz3113M7MwFdVaQJ1PjlAto",
+	"XuRng8pEaL0EIg7+Z2kFI9sTVEvr70qRLisjZScPf65YzMO19h7aGISvRVgi05SqHiPmiKdy1+rgRCuJ",
+	"K8QUPrz5hqWGBjpi8Zn34nsNlVbx8LKDn4tmNiLdqwh+dX1BbTIuSM4UIBCclogJ/WWUCzxdTV4jkbbf",
+	"wrtmLkGfZtdu7ZA3Jb08mwQX5+5DaD9tk+kEHyQB68k29y+7idVZ2GUodKykxLP2NmkOVg7s3Kfap23u",
+	"3jmxdJTicJFbzQ60XKuCXtVRuj9p8CdtDb2p93Iv29eIpT5eAXw1yE49ye0Qm9uSew/x8+pM3lzfz66s",
+	"oxFUdJA3/a1Vlvjh94/Z7Duk+nTL9UK1EK6fZ73NnV8ZZqCWsVM4eHXZOqklgh/anqk+HdcklTRhSTnJ",
+	"Q2VzSjXCsuLylLICpD57eYFKDHkMVdIjvuj6GyYyLnzvq40N54Q0bKfGSHkx9QlpK5rzlQtpwYeLwc+h",
+	"wMN1MrkS8kZsBbkdbX3g0hdQUrTQAvh56KrtUL4aYle1qc/sXYeWBojb1VE5Tqw7erue5JWc5xLAb5BX",
+	"uakxYmmgtHIKV5rlbvyHXnDRYxeBHauO97G/JXIa0N1rh4P9Omo4v+cpvlt3ja+i20Rf5f/PkN5W17LW",
+	"KPEexKdD1Oe9/PciP0fKPeWCVyB0gb9DX/JMhkQi5xq78RINHvwydRX7zcJs15zdEMM05rb+U44uxaX4",
+	"UFfMRNPAZCJBOLXLRnWx+0biNyZ8R2Ri2K05iPX1JGo+v83Sia1Cq/+6pBm44ba4EY04LRh7x1U54471",
+	"Pp7/ZBXIS2ETRhFtB0eHR0cjC8zNgscLYugV0yRXLGbYjtdmqNtu3vZ012RBr5lzumfMZhJhbsCPF+/f",
+	"jcipICcXv0aEkpRrcykQzwZDGgg6JUreENDqgWaiZst3OXNOSdQLcSLq85NimRaZsBEZ2u6+6aa4FFAc",
+	"WE+jv6ZpwSYwp44q3AmmAZcOdrsw+CHlV4xMOn2ZIBVKUcMmSAnNYqqYCkJTLaGaUIH1oXz7GCnYMAcn",
+	"XGNH82Q2IYrlUplVO3Lgx2cjeNtm8Kc8Zu6Ed5d3Hec0XjByNDocRAPUagYLY3L96uDg5uZmRPHxSKr5",
+	"gftWH/x0evL23cXbIXxTpT0PTkui/wBEf2HZAWl/EA1KWTv4ZnQ4OvTMmtP4CnPOB3NuFsV0FMvsQNKc",
+	"D0Hmz5k4UIVw9Z23w/qDYcaTJGU3VIEk+W3wc/nn4NNdNJA5EzTng1eDb918kL6G0uUA/m9u/Q8gixCl",
+	"cBHa4J/MfKC3J2Ua9KB5x90Kd1v1ykHz9rW7aJsPWhfOgTdK1U3ro8NDH2x3YbG2RIDfqvvW7q043UWD",
+	"lgzpd0AQP7uP6EVec6TA5XJdV6SXuN7Gt25H4s65dbeAhgB17x80X67d0bnuI3wndH/muo+aL9duwFz3",
+	"Eb7TuTBy3Re1NxGR3x5+t9EUcIJcSH+vpbtjEfEMi/VnR4MzqtMmKhMLuImqTy9F/ZbIDpO471GkfdXb",
+	"B9vw3Vr+r5eCbc9krXq1EN/Urql1NSDIhq6OxQpLYjZwF3wBS0rNYqXQxcKMwR6FXrD+I7Bk+551sLTW",
+	"elL+dvBZThHCXOpQMlkxBS8jJVOk83ahvZGSpFTNGTHS3iFsHzqVzIWt4byThTOzy2IpX0d9KQC14KMk",
+	"irUqsq3eU5ZXkw+uDQPXxLYfQH2G3bK4MNga08UP46s5BmmhAJGSqQ2xX4pcyhSGvZHqiik9ImfQoJkb",
+	"p5n+8+0Hgvg4+IMnd1ZXgtRbcJM1upWgM6D1+oF7OMEWAJeCm1azCHJhFKPuNirApm1tltkrfqjAnLvD",
+	"Nn7J5Oz9xQdSU4b+gP/c4cS2VvJSwL+9c4IrLAhjVUGkw0rGMlkVbDntHj6CF1OpTdmPVzHsTuGqJFvU",
+	"jZ0DWp1qykK51zJZ9kbn4UL6u6a9U4WH6sx2tCcgVkmWBk2OmlL8J2knXtttBAYwkuQyTTdcU/z0EtTJ",
+	"xQahNso8EcTvngLEgPhGkGOKlyfPQBAgeN98+0QYdIxiC064ttJTWQHwn+Rn/hrR9/3hU8GHJAxUiTKF",
+	"azIr0vTvJY26u+I1HgvGu+3xjFSj1iFTnh2ta5cxA3KORs6PcPh8Ks8hFKQuxsxCHvgT7CVDqJf+UhHX",
+	"faY9ibfdubZCEErOq042BIwwlPyudRUGzuyshF6Kdb2qglIRR+5IxfsZW/b66r3aS1tLM0xH4zayUaKt",
+	"wtGTMnklb0uexu2D49ZuZ9ImxZJuNpJi5JW6dpYs3jKAJ6ctt67lwGJXHavdBG4Y75jkXwOZnLTw+EVR",
+	"A2j695VKXr2rqf2tbDOvKNbbaiFNlCpge0rgMcFuUi7YMGEYz2cJegytp1AwdAnWv+LiUsAEUiUs2IOn",
+	"plA41x6Irgl3rj934x3+VvtsEnkt8fqIWOTb7l/w04TBLkwI3sSsfdmFLuvuXROQkFzsUPu5w+KjEP3t",
+	"UCRdeivjsVMuXAvLTU6X82o7HW6fPcEDeP/jCcFbUAtfZf+0mHA1v2zFmGWbmwPXNmm1DespEIyea8y6",
+	"sNlw5RBE84SBqYj/tTcB45jNAsSICOYv+7T36FU50z5ThVGwkgVrpDN081lDKkSrcdG+jKoVvay2MqsO",
+	"9whGma/XISn/ktsVrm0x1NNYQcflJno7qNzdyrP0HE0gexSVqGwYRE1dydG+rlGiZ8CSlRwXll6JdSGI",
+	"4zT9uqMQdoH9BiK2HHNJexr0geGI47SRw6b/DPGHNzZHYPhrlW6xPgzR/uAlGvFcohFds6BNz00x55yv",
+	"WwdcXy//bYNyLYHX3HV4hxhJ5s0IxK7VBoNowGF4CBQPfAa+z4ZpHvXrPI7R1xAlbuQD7hohXjvYku46",
+	"Wo+RYbwuu57V6K9etKlW3qSDrKcvk9l/4uIqcH03F1e+X0pzta005BpWJnaIKUumS8hFsQbIemf8458c",
+	"L/Hvl/h3f/HvZx6n2So8X4m48Fl9MJWi0Nt4B9zd+bbrgqvNwAupQhf5kphidzsXYBYFtj9Niywf6gLv",
+	"lodRiBTEyBwz8KyfY16kVBGKXfRcgb673tyLJinqxftlTSxOUXVFar8Y1W72Z8ZD5NhvifG57m344VCG",
+	"w8drxNuWqktcYZHePhPF5dN+PCiIlydym7i5V/tK8AW70zJNXIHJ00qcqE7O3meCYD5vh8k6F0lJ7dMO",
+	"vrdwlnjJVDLNyqDCSZ2tXCLuinyQcrBJTalBX732vv3fC6aWxPIH0dIezO203SksSl5lVF1BLVPKxZVL",
+	"s4nhhE0aHXbL9Nxvq/n/p93qf2A6y+FlcXh49B/+1rp/vH8HmbuntStsUTRhIwWKqdu1NB4IkTsRgot2",
+	"3Lw50PAlS63oDzsMblY1Tnnf1uqRNl6IfRe1EQHEX6+LiHyAaHJyPCF/SWxHsL/iQj9eYP16CLhWacXa",
+	"xbVSYC6IvXvThVVH5Nytr2RGmLjTrzQERfMSz3uBceboE/beX+THKghOjpsQRJ5OJsevgSBen8D/v39n",
+	"KeKXk5WI8nxwP+jelHeqQeKFUTQ2rmqzLlqnbCZdyKJU5oG3UIiyZBVIjQvbdiImx9WoNqWK0WQZUjlW",
+	"gNG6ZW1nqq5d01ZCgxfJ12X8KmACd7ztBNAPeBNBQpdN+uKCnFBBExohVDzL+FxRVwwWgst/uhxjgt7Y",
+	"VZSGYAvWmnYh+4luARjbHi4mkh6gunBncaPtGIBooVoFRr2rVIjDplKmjIqgAKDKrGibhXNyKoau6tZf",
+	"ddwBcBVYsRtgbAeomqrch6YeI92DrXRq5cnsvkH0HVxsm+FZ0t3H28HNFjdUjd48Fl+SM+l55KA6Vv0K",
+	"DAk8za2CXlNdw8ZEtNmVAbBQ8E9IUfOStHwKZdsfzKv3cs73prgUzRZ02rbzL0TphPC5rJqlLIZzVtia",
+	"SCmYzRj9eLFiMKwNdJos9vNo6IDrvBIvDokt2mI9ci7Hy/HR0/HxIo6fiTi+jxdnywKqdu5n3ZmDtTzO",
+	"bQNiahK453FCbNMnK8WoIO/eQKaoKyQQ2jCaoJ+ZYgYpwR4CUbl0X3FUrzeA3wNFR9UYMLbz9zQrsfCd",
+	"S4GD+R/dMeILGzRWO9n7XygxLMulAuKAUvzIrmO6xP9GRFGz8ECBqQYGiC1XikiREyMvhfUmtSCFg+zo",
+	"+//AsglybAf1ajvsMSVIhQ3cK/YZDytY/M1Cpvaa2C0rnWwJ16YzaJ93ZD72UbRDDu1LkdbTSmRaSotO",
+	"kjjXL9Va26AShRmkMreqNDsiU7VrubxY+uqruWxbDyvt2/XB4dTtzgnabBa0JrGq1SjoPmlVmzNBno9c",
+	"3m8aVA2BAao5W4ekUND961Bgv+isiE20vX2uhFI63zZVonODDMzcuXtFY2VRmVahrT7XuZcGr2jBNkaX",
+	"ovOsp9uxfM9N3wM1oPO9zw3P+P9hsIwXT0Pw/pYncjI0bu4JlWgB4dVI8nnmPXRI/su1lT2rdFl+jfF8",
+	"fbRdyUitxd5X2LLKL239TlhDPE2t6IiIlsredvMFN3p6KZ541ht0F1YuzCqirHH69VGAxbcrl3DtL1+K",
+	"JJ6DXNpOLK2zAf5MsuglHf8ZyrAXY3JHY9Js4vZthH4zu7XfgHVkoyfw0/U3ZaNhF0ARRcYUj7EkX0eX",
+	"onYDBrSt1b7jLl6TMcno7SQq72hDSxOT5tIbusSev5oJ29uYCmivkPKYm7KT99pQ9fZn2kuw+umC1asP",
+	"vJcw7RcUprWiCF7DxoaW22z/ZxRNcdV+4O7T3f8fAIaTIX06ywAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,1603 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: taxcalculatorpb/tax_calculator.proto
+
+// The tax calculator service, the gRPC equivalent of the bracket and calculation operations of the version 2 REST API.
+
+package taxcalculatorpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTaxYearsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTaxYearsRequest) Reset() {
+	*x = ListTaxYearsRequest{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxYearsRequest) ProtoMessage() {}
+
+func (x *ListTaxYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxYearsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxYearsRequest) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{0}
+}
+
+type ListTaxYearsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaxYears       []*TaxYear `protobuf:"bytes,1,rep,name=tax_years,json=taxYears,proto3" json:"tax_years,omitempty"`
+	DatasetVersion string     `protobuf:"bytes,2,opt,name=dataset_version,json=datasetVersion,proto3" json:"dataset_version,omitempty"`
+}
+
+func (x *ListTaxYearsResponse) Reset() {
+	*x = ListTaxYearsResponse{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxYearsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxYearsResponse) ProtoMessage() {}
+
+func (x *ListTaxYearsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxYearsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxYearsResponse) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTaxYearsResponse) GetTaxYears() []*TaxYear {
+	if x != nil {
+		return x.TaxYears
+	}
+	return nil
+}
+
+func (x *ListTaxYearsResponse) GetDatasetVersion() string {
+	if x != nil {
+		return x.DatasetVersion
+	}
+	return ""
+}
+
+type GetTaxYearRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tax year, e.g. `2022`, or a symbolic year: `latest`, `current` or `previous`.
+	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *GetTaxYearRequest) Reset() {
+	*x = GetTaxYearRequest{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxYearRequest) ProtoMessage() {}
+
+func (x *GetTaxYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxYearRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxYearRequest) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTaxYearRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+// TaxYear is the federal tax brackets of a tax year.
+type TaxYear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year         string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	Jurisdiction string `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// ISO 4217 currency code of the amounts.
+	Currency       string        `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Brackets       []*TaxBracket `protobuf:"bytes,4,rep,name=brackets,proto3" json:"brackets,omitempty"`
+	Provenance     *Provenance   `protobuf:"bytes,5,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	DatasetVersion string        `protobuf:"bytes,6,opt,name=dataset_version,json=datasetVersion,proto3" json:"dataset_version,omitempty"`
+}
+
+func (x *TaxYear) Reset() {
+	*x = TaxYear{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxYear) ProtoMessage() {}
+
+func (x *TaxYear) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxYear.ProtoReflect.Descriptor instead.
+func (*TaxYear) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaxYear) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *TaxYear) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *TaxYear) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TaxYear) GetBrackets() []*TaxBracket {
+	if x != nil {
+		return x.Brackets
+	}
+	return nil
+}
+
+func (x *TaxYear) GetProvenance() *Provenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *TaxYear) GetDatasetVersion() string {
+	if x != nil {
+		return x.DatasetVersion
+	}
+	return ""
+}
+
+type TaxBracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Upper limit of the bracket, unset for the open-ended top bracket.
+	Max *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// Marginal rate of the bracket as a fraction, e.g. 0.205.
+	Rate float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *TaxBracket) Reset() {
+	*x = TaxBracket{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxBracket) ProtoMessage() {}
+
+func (x *TaxBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxBracket.ProtoReflect.Descriptor instead.
+func (*TaxBracket) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaxBracket) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TaxBracket) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *TaxBracket) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// Provenance is where the federal tax brackets of a tax year come from.
+type Provenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Name of the authoritative source document.
+	Source    string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SourceUrl string `protobuf:"bytes,3,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	Notes     string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Hash of the dataset the tax brackets are loaded from.
+	DatasetVersion string `protobuf:"bytes,5,opt,name=dataset_version,json=datasetVersion,proto3" json:"dataset_version,omitempty"`
+	// When the source document was published, as YYYY-MM-DD, when known.
+	PublicationDate string `protobuf:"bytes,6,opt,name=publication_date,json=publicationDate,proto3" json:"publication_date,omitempty"`
+	// When the tax brackets were last checked against the source document, as YYYY-MM-DD.
+	LastVerified string `protobuf:"bytes,7,opt,name=last_verified,json=lastVerified,proto3" json:"last_verified,omitempty"`
+}
+
+func (x *Provenance) Reset() {
+	*x = Provenance{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Provenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provenance) ProtoMessage() {}
+
+func (x *Provenance) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provenance.ProtoReflect.Descriptor instead.
+func (*Provenance) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Provenance) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *Provenance) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Provenance) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *Provenance) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Provenance) GetDatasetVersion() string {
+	if x != nil {
+		return x.DatasetVersion
+	}
+	return ""
+}
+
+func (x *Provenance) GetPublicationDate() string {
+	if x != nil {
+		return x.PublicationDate
+	}
+	return ""
+}
+
+func (x *Provenance) GetLastVerified() string {
+	if x != nil {
+		return x.LastVerified
+	}
+	return ""
+}
+
+type CalculateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tax year, e.g. `2022`, or a symbolic year: `latest`, `current` or `previous`.
+	Year   string  `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	Salary float64 `protobuf:"fixed64,2,opt,name=salary,proto3" json:"salary,omitempty"`
+	// Tax jurisdiction, either `CA` (default) or `US`.
+	Jurisdiction string `protobuf:"bytes,3,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// US filing status, one of `single`, `married_filing_jointly`, `married_filing_separately` or `head_of_household`.
+	// Required for the `US` jurisdiction.
+	FilingStatus string `protobuf:"bytes,4,opt,name=filing_status,json=filingStatus,proto3" json:"filing_status,omitempty"`
+	// Province of residence for the `CA` jurisdiction, one of `AB`, `BC`, `ON` or `QC`.
+	Province string `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
+	// Deductions, e.g. RRSP contributions, subtracted from the salary before the brackets are applied.
+	Deductions float64 `protobuf:"fixed64,6,opt,name=deductions,proto3" json:"deductions,omitempty"`
+	// Income tax already withheld at source, e.g. from pay slips.
+	TaxWithheld float64 `protobuf:"fixed64,7,opt,name=tax_withheld,json=taxWithheld,proto3" json:"tax_withheld,omitempty"`
+	// Tax instalments already paid for the year.
+	InstalmentsPaid float64 `protobuf:"fixed64,8,opt,name=instalments_paid,json=instalmentsPaid,proto3" json:"instalments_paid,omitempty"`
+	// Other income items, e.g. foreign employment income, added to the salary.
+	IncomeItems []*IncomeItemInput `protobuf:"bytes,9,rep,name=income_items,json=incomeItems,proto3" json:"income_items,omitempty"`
+	// First day of residence in Canada, for immigrants, as YYYY-MM-DD.
+	ResidencyStartDate string `protobuf:"bytes,10,opt,name=residency_start_date,json=residencyStartDate,proto3" json:"residency_start_date,omitempty"`
+	// Last day of residence in Canada, for emigrants, as YYYY-MM-DD.
+	ResidencyEndDate string `protobuf:"bytes,11,opt,name=residency_end_date,json=residencyEndDate,proto3" json:"residency_end_date,omitempty"`
+	// Set for non-residents of Canada, who are taxed on their Canadian-source income only.
+	NonResident bool `protobuf:"varint,12,opt,name=non_resident,json=nonResident,proto3" json:"non_resident,omitempty"`
+	// Part of the salary that is Canadian-source income, for non-residents.
+	CanadianSourceIncome float64 `protobuf:"fixed64,13,opt,name=canadian_source_income,json=canadianSourceIncome,proto3" json:"canadian_source_income,omitempty"`
+}
+
+func (x *CalculateRequest) Reset() {
+	*x = CalculateRequest{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRequest) ProtoMessage() {}
+
+func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRequest.ProtoReflect.Descriptor instead.
+func (*CalculateRequest) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalculateRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetSalary() float64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetFilingStatus() string {
+	if x != nil {
+		return x.FilingStatus
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetDeductions() float64 {
+	if x != nil {
+		return x.Deductions
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetTaxWithheld() float64 {
+	if x != nil {
+		return x.TaxWithheld
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetInstalmentsPaid() float64 {
+	if x != nil {
+		return x.InstalmentsPaid
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetIncomeItems() []*IncomeItemInput {
+	if x != nil {
+		return x.IncomeItems
+	}
+	return nil
+}
+
+func (x *CalculateRequest) GetResidencyStartDate() string {
+	if x != nil {
+		return x.ResidencyStartDate
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetResidencyEndDate() string {
+	if x != nil {
+		return x.ResidencyEndDate
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetNonResident() bool {
+	if x != nil {
+		return x.NonResident
+	}
+	return false
+}
+
+func (x *CalculateRequest) GetCanadianSourceIncome() float64 {
+	if x != nil {
+		return x.CanadianSourceIncome
+	}
+	return 0
+}
+
+type IncomeItemInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 currency code, e.g. `USD` or `EUR`. Defaults to the currency of the jurisdiction.
+	Currency    string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *IncomeItemInput) Reset() {
+	*x = IncomeItemInput{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeItemInput) ProtoMessage() {}
+
+func (x *IncomeItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeItemInput.ProtoReflect.Descriptor instead.
+func (*IncomeItemInput) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IncomeItemInput) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *IncomeItemInput) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *IncomeItemInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Calculation is the tax owed for a year, with the same fields as the version 2 calculation of the REST API.
+type Calculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaxYear                string   `protobuf:"bytes,1,opt,name=tax_year,json=taxYear,proto3" json:"tax_year,omitempty"`
+	Jurisdiction           string   `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	Currency               string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	FilingStatus           *string  `protobuf:"bytes,4,opt,name=filing_status,json=filingStatus,proto3,oneof" json:"filing_status,omitempty"`
+	Province               *string  `protobuf:"bytes,5,opt,name=province,proto3,oneof" json:"province,omitempty"`
+	ProvincialTaxAuthority *string  `protobuf:"bytes,6,opt,name=provincial_tax_authority,json=provincialTaxAuthority,proto3,oneof" json:"provincial_tax_authority,omitempty"`
+	Salary                 float64  `protobuf:"fixed64,7,opt,name=salary,proto3" json:"salary,omitempty"`
+	TotalIncome            float64  `protobuf:"fixed64,8,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	Deductions             float64  `protobuf:"fixed64,9,opt,name=deductions,proto3" json:"deductions,omitempty"`
+	StandardDeduction      *float64 `protobuf:"fixed64,10,opt,name=standard_deduction,json=standardDeduction,proto3,oneof" json:"standard_deduction,omitempty"`
+	TaxableIncome          float64  `protobuf:"fixed64,11,opt,name=taxable_income,json=taxableIncome,proto3" json:"taxable_income,omitempty"`
+	FederalTaxOwed         float64  `protobuf:"fixed64,12,opt,name=federal_tax_owed,json=federalTaxOwed,proto3" json:"federal_tax_owed,omitempty"`
+	ProvincialTaxOwed      float64  `protobuf:"fixed64,13,opt,name=provincial_tax_owed,json=provincialTaxOwed,proto3" json:"provincial_tax_owed,omitempty"`
+	TotalTaxOwed           float64  `protobuf:"fixed64,14,opt,name=total_tax_owed,json=totalTaxOwed,proto3" json:"total_tax_owed,omitempty"`
+	// Total tax owed as a fraction of the total income, e.g. 0.3123.
+	EffectiveTaxRate float64 `protobuf:"fixed64,15,opt,name=effective_tax_rate,json=effectiveTaxRate,proto3" json:"effective_tax_rate,omitempty"`
+	// Sum of the federal and provincial rates of the bands the taxable income falls in.
+	MarginalTaxRate float64         `protobuf:"fixed64,16,opt,name=marginal_tax_rate,json=marginalTaxRate,proto3" json:"marginal_tax_rate,omitempty"`
+	NetIncome       float64         `protobuf:"fixed64,17,opt,name=net_income,json=netIncome,proto3" json:"net_income,omitempty"`
+	Bands           []*Band         `protobuf:"bytes,18,rep,name=bands,proto3" json:"bands,omitempty"`
+	ProvincialBands []*Band         `protobuf:"bytes,19,rep,name=provincial_bands,json=provincialBands,proto3" json:"provincial_bands,omitempty"`
+	Credits         []*Adjustment   `protobuf:"bytes,20,rep,name=credits,proto3" json:"credits,omitempty"`
+	Contributions   []*Adjustment   `protobuf:"bytes,21,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	IncomeItems     []*IncomeItem   `protobuf:"bytes,22,rep,name=income_items,json=incomeItems,proto3" json:"income_items,omitempty"`
+	TaxWithheld     float64         `protobuf:"fixed64,23,opt,name=tax_withheld,json=taxWithheld,proto3" json:"tax_withheld,omitempty"`
+	InstalmentsPaid float64         `protobuf:"fixed64,24,opt,name=instalments_paid,json=instalmentsPaid,proto3" json:"instalments_paid,omitempty"`
+	Refund          float64         `protobuf:"fixed64,25,opt,name=refund,proto3" json:"refund,omitempty"`
+	BalanceOwing    float64         `protobuf:"fixed64,26,opt,name=balance_owing,json=balanceOwing,proto3" json:"balance_owing,omitempty"`
+	Residency       *Residency      `protobuf:"bytes,27,opt,name=residency,proto3" json:"residency,omitempty"`
+	Rounding        *RoundingPolicy `protobuf:"bytes,28,opt,name=rounding,proto3" json:"rounding,omitempty"`
+	Provenance      *Provenance     `protobuf:"bytes,29,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	DatasetVersion  string          `protobuf:"bytes,30,opt,name=dataset_version,json=datasetVersion,proto3" json:"dataset_version,omitempty"`
+}
+
+func (x *Calculation) Reset() {
+	*x = Calculation{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calculation) ProtoMessage() {}
+
+func (x *Calculation) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calculation.ProtoReflect.Descriptor instead.
+func (*Calculation) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Calculation) GetTaxYear() string {
+	if x != nil {
+		return x.TaxYear
+	}
+	return ""
+}
+
+func (x *Calculation) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *Calculation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Calculation) GetFilingStatus() string {
+	if x != nil && x.FilingStatus != nil {
+		return *x.FilingStatus
+	}
+	return ""
+}
+
+func (x *Calculation) GetProvince() string {
+	if x != nil && x.Province != nil {
+		return *x.Province
+	}
+	return ""
+}
+
+func (x *Calculation) GetProvincialTaxAuthority() string {
+	if x != nil && x.ProvincialTaxAuthority != nil {
+		return *x.ProvincialTaxAuthority
+	}
+	return ""
+}
+
+func (x *Calculation) GetSalary() float64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *Calculation) GetTotalIncome() float64 {
+	if x != nil {
+		return x.TotalIncome
+	}
+	return 0
+}
+
+func (x *Calculation) GetDeductions() float64 {
+	if x != nil {
+		return x.Deductions
+	}
+	return 0
+}
+
+func (x *Calculation) GetStandardDeduction() float64 {
+	if x != nil && x.StandardDeduction != nil {
+		return *x.StandardDeduction
+	}
+	return 0
+}
+
+func (x *Calculation) GetTaxableIncome() float64 {
+	if x != nil {
+		return x.TaxableIncome
+	}
+	return 0
+}
+
+func (x *Calculation) GetFederalTaxOwed() float64 {
+	if x != nil {
+		return x.FederalTaxOwed
+	}
+	return 0
+}
+
+func (x *Calculation) GetProvincialTaxOwed() float64 {
+	if x != nil {
+		return x.ProvincialTaxOwed
+	}
+	return 0
+}
+
+func (x *Calculation) GetTotalTaxOwed() float64 {
+	if x != nil {
+		return x.TotalTaxOwed
+	}
+	return 0
+}
+
+func (x *Calculation) GetEffectiveTaxRate() float64 {
+	if x != nil {
+		return x.EffectiveTaxRate
+	}
+	return 0
+}
+
+func (x *Calculation) GetMarginalTaxRate() float64 {
+	if x != nil {
+		return x.MarginalTaxRate
+	}
+	return 0
+}
+
+func (x *Calculation) GetNetIncome() float64 {
+	if x != nil {
+		return x.NetIncome
+	}
+	return 0
+}
+
+func (x *Calculation) GetBands() []*Band {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *Calculation) GetProvincialBands() []*Band {
+	if x != nil {
+		return x.ProvincialBands
+	}
+	return nil
+}
+
+func (x *Calculation) GetCredits() []*Adjustment {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *Calculation) GetContributions() []*Adjustment {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+func (x *Calculation) GetIncomeItems() []*IncomeItem {
+	if x != nil {
+		return x.IncomeItems
+	}
+	return nil
+}
+
+func (x *Calculation) GetTaxWithheld() float64 {
+	if x != nil {
+		return x.TaxWithheld
+	}
+	return 0
+}
+
+func (x *Calculation) GetInstalmentsPaid() float64 {
+	if x != nil {
+		return x.InstalmentsPaid
+	}
+	return 0
+}
+
+func (x *Calculation) GetRefund() float64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *Calculation) GetBalanceOwing() float64 {
+	if x != nil {
+		return x.BalanceOwing
+	}
+	return 0
+}
+
+func (x *Calculation) GetResidency() *Residency {
+	if x != nil {
+		return x.Residency
+	}
+	return nil
+}
+
+func (x *Calculation) GetRounding() *RoundingPolicy {
+	if x != nil {
+		return x.Rounding
+	}
+	return nil
+}
+
+func (x *Calculation) GetProvenance() *Provenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *Calculation) GetDatasetVersion() string {
+	if x != nil {
+		return x.DatasetVersion
+	}
+	return ""
+}
+
+type Band struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Upper limit of the band, unset for the open-ended top band.
+	Max  *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Rate float64  `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Part of the taxable income that falls in the band.
+	TaxableAmount float64 `protobuf:"fixed64,4,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	TaxOwed       float64 `protobuf:"fixed64,5,opt,name=tax_owed,json=taxOwed,proto3" json:"tax_owed,omitempty"`
+}
+
+func (x *Band) Reset() {
+	*x = Band{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Band) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Band) ProtoMessage() {}
+
+func (x *Band) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Band.ProtoReflect.Descriptor instead.
+func (*Band) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Band) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Band) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *Band) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Band) GetTaxableAmount() float64 {
+	if x != nil {
+		return x.TaxableAmount
+	}
+	return 0
+}
+
+func (x *Band) GetTaxOwed() float64 {
+	if x != nil {
+		return x.TaxOwed
+	}
+	return 0
+}
+
+type Adjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Adjustment) Reset() {
+	*x = Adjustment{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Adjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adjustment) ProtoMessage() {}
+
+func (x *Adjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adjustment.ProtoReflect.Descriptor instead.
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Adjustment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Adjustment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type IncomeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string  `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency    string  `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Annual-average exchange rate of the tax year used to convert the amount.
+	ExchangeRate    float64 `protobuf:"fixed64,4,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	ConvertedAmount float64 `protobuf:"fixed64,5,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
+}
+
+func (x *IncomeItem) Reset() {
+	*x = IncomeItem{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeItem) ProtoMessage() {}
+
+func (x *IncomeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeItem.ProtoReflect.Descriptor instead.
+func (*IncomeItem) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IncomeItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IncomeItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *IncomeItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *IncomeItem) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *IncomeItem) GetConvertedAmount() float64 {
+	if x != nil {
+		return x.ConvertedAmount
+	}
+	return 0
+}
+
+// Residency is the residency of part-year residents and non-residents, and the proration factors it implies.
+type Residency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either `part_year_resident` or `non_resident`.
+	Status       string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DaysResident int32  `protobuf:"varint,2,opt,name=days_resident,json=daysResident,proto3" json:"days_resident,omitempty"`
+	DaysInYear   int32  `protobuf:"varint,3,opt,name=days_in_year,json=daysInYear,proto3" json:"days_in_year,omitempty"`
+	// Factor the non-refundable credits are prorated by.
+	CreditProrationFactor float64 `protobuf:"fixed64,4,opt,name=credit_proration_factor,json=creditProrationFactor,proto3" json:"credit_proration_factor,omitempty"`
+	// Share of the salary that is taxed.
+	IncomeProrationFactor float64 `protobuf:"fixed64,5,opt,name=income_proration_factor,json=incomeProrationFactor,proto3" json:"income_proration_factor,omitempty"`
+}
+
+func (x *Residency) Reset() {
+	*x = Residency{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Residency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Residency) ProtoMessage() {}
+
+func (x *Residency) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Residency.ProtoReflect.Descriptor instead.
+func (*Residency) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Residency) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Residency) GetDaysResident() int32 {
+	if x != nil {
+		return x.DaysResident
+	}
+	return 0
+}
+
+func (x *Residency) GetDaysInYear() int32 {
+	if x != nil {
+		return x.DaysInYear
+	}
+	return 0
+}
+
+func (x *Residency) GetCreditProrationFactor() float64 {
+	if x != nil {
+		return x.CreditProrationFactor
+	}
+	return 0
+}
+
+func (x *Residency) GetIncomeProrationFactor() float64 {
+	if x != nil {
+		return x.IncomeProrationFactor
+	}
+	return 0
+}
+
+// RoundingPolicy is how the amounts of the calculation are rounded, as set for the jurisdiction in the dataset.
+type RoundingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either `per_line`, rounding every band and credit, or `total`, rounding only the totals.
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Either `half_up` or `half_even` (banker's rounding).
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Either `cents` or `dollars`.
+	Precision string `protobuf:"bytes,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	// Number of decimals of the effective tax rate.
+	EffectiveRateDecimals int32 `protobuf:"varint,4,opt,name=effective_rate_decimals,json=effectiveRateDecimals,proto3" json:"effective_rate_decimals,omitempty"`
+}
+
+func (x *RoundingPolicy) Reset() {
+	*x = RoundingPolicy{}
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundingPolicy) ProtoMessage() {}
+
+func (x *RoundingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_taxcalculatorpb_tax_calculator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundingPolicy.ProtoReflect.Descriptor instead.
+func (*RoundingPolicy) Descriptor() ([]byte, []int) {
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RoundingPolicy) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *RoundingPolicy) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RoundingPolicy) GetPrecision() string {
+	if x != nil {
+		return x.Precision
+	}
+	return ""
+}
+
+func (x *RoundingPolicy) GetEffectiveRateDecimals() int32 {
+	if x != nil {
+		return x.EffectiveRateDecimals
+	}
+	return 0
+}
+
+var File_taxcalculatorpb_tax_calculator_proto protoreflect.FileDescriptor
+
+var file_taxcalculatorpb_tax_calculator_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x70,
+	0x62, 0x2f, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x77, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x74, 0x61, 0x78, 0x5f, 0x79,
+	0x65, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x78,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x78, 0x59, 0x65, 0x61, 0x72, 0x52, 0x08, 0x74, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x22, 0xfe, 0x01, 0x0a, 0x07, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x38, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x78, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x08, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0a, 0x54, 0x61, 0x78, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x90,
+	0x04, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12,
+	0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x68, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x78, 0x57,
+	0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x61,
+	0x69, 0x64, 0x12, 0x44, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65,
+	0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x63,
+	0x61, 0x6e, 0x61, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x63, 0x61, 0x6e,
+	0x61, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x22, 0x67, 0x0a, 0x0f, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x0b, 0x0a, 0x0b, 0x43,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
+	0x78, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61,
+	0x78, 0x59, 0x65, 0x61, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x72,
+	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x3d, 0x0a, 0x18, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x74,
+	0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x54, 0x61, 0x78, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x61, 0x78, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x61, 0x78, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x6c,
+	0x5f, 0x74, 0x61, 0x78, 0x5f, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x61, 0x78, 0x4f, 0x77, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x61,
+	0x78, 0x5f, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x61, 0x78, 0x4f, 0x77, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x6f, 0x77, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x61,
+	0x78, 0x4f, 0x77, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x78, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x74, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x6e, 0x64, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x10,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12,
+	0x36, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0b, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x78, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x61, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x4f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x78,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64,
+	0x5f, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x04, 0x42,
+	0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x78, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x61, 0x78, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x61, 0x78, 0x4f, 0x77,
+	0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x38, 0x0a, 0x0a, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x09, 0x52, 0x65,
+	0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x69, 0x6e, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x79, 0x73,
+	0x49, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x36,
+	0x0a, 0x17, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x15, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x32, 0x8c, 0x02, 0x0a, 0x0d,
+	0x54, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x5d, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x73, 0x12, 0x25, 0x2e,
+	0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x78, 0x59,
+	0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x78,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61,
+	0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x36, 0x5a, 0x34, 0x70, 0x61,
+	0x74, 0x72, 0x69, 0x63, 0x6b, 0x79, 0x61, 0x75, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x74, 0x61, 0x78, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_taxcalculatorpb_tax_calculator_proto_rawDescOnce sync.Once
+	file_taxcalculatorpb_tax_calculator_proto_rawDescData = file_taxcalculatorpb_tax_calculator_proto_rawDesc
+)
+
+func file_taxcalculatorpb_tax_calculator_proto_rawDescGZIP() []byte {
+	file_taxcalculatorpb_tax_calculator_proto_rawDescOnce.Do(func() {
+		file_taxcalculatorpb_tax_calculator_proto_rawDescData = protoimpl.X.CompressGZIP(file_taxcalculatorpb_tax_calculator_proto_rawDescData)
+	})
+	return file_taxcalculatorpb_tax_calculator_proto_rawDescData
+}
+
+var file_taxcalculatorpb_tax_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_taxcalculatorpb_tax_calculator_proto_goTypes = []any{
+	(*ListTaxYearsRequest)(nil),  // 0: taxcalculator.v1.ListTaxYearsRequest
+	(*ListTaxYearsResponse)(nil), // 1: taxcalculator.v1.ListTaxYearsResponse
+	(*GetTaxYearRequest)(nil),    // 2: taxcalculator.v1.GetTaxYearRequest
+	(*TaxYear)(nil),              // 3: taxcalculator.v1.TaxYear
+	(*TaxBracket)(nil),           // 4: taxcalculator.v1.TaxBracket
+	(*Provenance)(nil),           // 5: taxcalculator.v1.Provenance
+	(*CalculateRequest)(nil),     // 6: taxcalculator.v1.CalculateRequest
+	(*IncomeItemInput)(nil),      // 7: taxcalculator.v1.IncomeItemInput
+	(*Calculation)(nil),          // 8: taxcalculator.v1.Calculation
+	(*Band)(nil),                 // 9: taxcalculator.v1.Band
+	(*Adjustment)(nil),           // 10: taxcalculator.v1.Adjustment
+	(*IncomeItem)(nil),           // 11: taxcalculator.v1.IncomeItem
+	(*Residency)(nil),            // 12: taxcalculator.v1.Residency
+	(*RoundingPolicy)(nil),       // 13: taxcalculator.v1.RoundingPolicy
+}
+var file_taxcalculatorpb_tax_calculator_proto_depIdxs = []int32{
+	3,  // 0: taxcalculator.v1.ListTaxYearsResponse.tax_years:type_name -> taxcalculator.v1.TaxYear
+	4,  // 1: taxcalculator.v1.TaxYear.brackets:type_name -> taxcalculator.v1.TaxBracket
+	5,  // 2: taxcalculator.v1.TaxYear.provenance:type_name -> taxcalculator.v1.Provenance
+	7,  // 3: taxcalculator.v1.CalculateRequest.income_items:type_name -> taxcalculator.v1.IncomeItemInput
+	9,  // 4: taxcalculator.v1.Calculation.bands:type_name -> taxcalculator.v1.Band
+	9,  // 5: taxcalculator.v1.Calculation.provincial_bands:type_name -> taxcalculator.v1.Band
+	10, // 6: taxcalculator.v1.Calculation.credits:type_name -> taxcalculator.v1.Adjustment
+	10, // 7: taxcalculator.v1.Calculation.contributions:type_name -> taxcalculator.v1.Adjustment
+	11, // 8: taxcalculator.v1.Calculation.income_items:type_name -> taxcalculator.v1.IncomeItem
+	12, // 9: taxcalculator.v1.Calculation.residency:type_name -> taxcalculator.v1.Residency
+	13, // 10: taxcalculator.v1.Calculation.rounding:type_name -> taxcalculator.v1.RoundingPolicy
+	5,  // 11: taxcalculator.v1.Calculation.provenance:type_name -> taxcalculator.v1.Provenance
+	0,  // 12: taxcalculator.v1.TaxCalculator.ListTaxYears:input_type -> taxcalculator.v1.ListTaxYearsRequest
+	2,  // 13: taxcalculator.v1.TaxCalculator.GetTaxYear:input_type -> taxcalculator.v1.GetTaxYearRequest
+	6,  // 14: taxcalculator.v1.TaxCalculator.Calculate:input_type -> taxcalculator.v1.CalculateRequest
+	1,  // 15: taxcalculator.v1.TaxCalculator.ListTaxYears:output_type -> taxcalculator.v1.ListTaxYearsResponse
+	3,  // 16: taxcalculator.v1.TaxCalculator.GetTaxYear:output_type -> taxcalculator.v1.TaxYear
+	8,  // 17: taxcalculator.v1.TaxCalculator.Calculate:output_type -> taxcalculator.v1.Calculation
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_taxcalculatorpb_tax_calculator_proto_init() }
+func file_taxcalculatorpb_tax_calculator_proto_init() {
+	if File_taxcalculatorpb_tax_calculator_proto != nil {
+		return
+	}
+	file_taxcalculatorpb_tax_calculator_proto_msgTypes[4].OneofWrappers = []any{}
+	file_taxcalculatorpb_tax_calculator_proto_msgTypes[8].OneofWrappers = []any{}
+	file_taxcalculatorpb_tax_calculator_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_taxcalculatorpb_tax_calculator_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_taxcalculatorpb_tax_calculator_proto_goTypes,
+		DependencyIndexes: file_taxcalculatorpb_tax_calculator_proto_depIdxs,
+		MessageInfos:      file_taxcalculatorpb_tax_calculator_proto_msgTypes,
+	}.Build()
+	File_taxcalculatorpb_tax_calculator_proto = out.File
+	file_taxcalculatorpb_tax_calculator_proto_rawDesc = nil
+	file_taxcalculatorpb_tax_calculator_proto_goTypes = nil
+	file_taxcalculatorpb_tax_calculator_proto_depIdxs = nil
+}
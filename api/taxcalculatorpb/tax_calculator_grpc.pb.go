@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: taxcalculatorpb/tax_calculator.proto
+
+// The tax calculator service, the gRPC equivalent of the bracket and calculation operations of the version 2 REST API.
+
+package taxcalculatorpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TaxCalculator_ListTaxYears_FullMethodName = "/taxcalculator.v1.TaxCalculator/ListTaxYears"
+	TaxCalculator_GetTaxYear_FullMethodName   = "/taxcalculator.v1.TaxCalculator/GetTaxYear"
+	TaxCalculator_Calculate_FullMethodName    = "/taxcalculator.v1.TaxCalculator/Calculate"
+)
+
+// TaxCalculatorClient is the client API for TaxCalculator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TaxCalculator serves the federal tax brackets of the tax years and calculates the tax owed for a year.
+//
+// Errors are returned with the INVALID_ARGUMENT status for invalid requests, with the invalid field in a
+// google.rpc.BadRequest detail, and NOT_FOUND for tax years the dataset does not have.
+type TaxCalculatorClient interface {
+	// ListTaxYears returns the federal tax brackets of every year, sorted by year.
+	ListTaxYears(ctx context.Context, in *ListTaxYearsRequest, opts ...grpc.CallOption) (*ListTaxYearsResponse, error)
+	// GetTaxYear returns the federal tax brackets of a year.
+	GetTaxYear(ctx context.Context, in *GetTaxYearRequest, opts ...grpc.CallOption) (*TaxYear, error)
+	// Calculate calculates the tax owed for a year.
+	Calculate(ctx context.Context, in *CalculateRequest, opts ...grpc.CallOption) (*Calculation, error)
+}
+
+type taxCalculatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaxCalculatorClient(cc grpc.ClientConnInterface) TaxCalculatorClient {
+	return &taxCalculatorClient{cc}
+}
+
+func (c *taxCalculatorClient) ListTaxYears(ctx context.Context, in *ListTaxYearsRequest, opts ...grpc.CallOption) (*ListTaxYearsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxYearsResponse)
+	err := c.cc.Invoke(ctx, TaxCalculator_ListTaxYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxCalculatorClient) GetTaxYear(ctx context.Context, in *GetTaxYearRequest, opts ...grpc.CallOption) (*TaxYear, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxYear)
+	err := c.cc.Invoke(ctx, TaxCalculator_GetTaxYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxCalculatorClient) Calculate(ctx context.Context, in *CalculateRequest, opts ...grpc.CallOption) (*Calculation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Calculation)
+	err := c.cc.Invoke(ctx, TaxCalculator_Calculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaxCalculatorServer is the server API for TaxCalculator service.
+// All implementations must embed UnimplementedTaxCalculatorServer
+// for forward compatibility.
+//
+// TaxCalculator serves the federal tax brackets of the tax years and calculates the tax owed for a year.
+//
+// Errors are returned with the INVALID_ARGUMENT status for invalid requests, with the invalid field in a
+// google.rpc.BadRequest detail, and NOT_FOUND for tax years the dataset does not have.
+type TaxCalculatorServer interface {
+	// ListTaxYears returns the federal tax brackets of every year, sorted by year.
+	ListTaxYears(context.Context, *ListTaxYearsRequest) (*ListTaxYearsResponse, error)
+	// GetTaxYear returns the federal tax brackets of a year.
+	GetTaxYear(context.Context, *GetTaxYearRequest) (*TaxYear, error)
+	// Calculate calculates the tax owed for a year.
+	Calculate(context.Context, *CalculateRequest) (*Calculation, error)
+	mustEmbedUnimplementedTaxCalculatorServer()
+}
+
+// UnimplementedTaxCalculatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaxCalculatorServer struct{}
+
+func (UnimplementedTaxCalculatorServer) ListTaxYears(context.Context, *ListTaxYearsRequest) (*ListTaxYearsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxYears not implemented")
+}
+func (UnimplementedTaxCalculatorServer) GetTaxYear(context.Context, *GetTaxYearRequest) (*TaxYear, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxYear not implemented")
+}
+func (UnimplementedTaxCalculatorServer) Calculate(context.Context, *CalculateRequest) (*Calculation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Calculate not implemented")
+}
+func (UnimplementedTaxCalculatorServer) mustEmbedUnimplementedTaxCalculatorServer() {}
+func (UnimplementedTaxCalculatorServer) testEmbeddedByValue()                       {}
+
+// UnsafeTaxCalculatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaxCalculatorServer will
+// result in compilation errors.
+type UnsafeTaxCalculatorServer interface {
+	mustEmbedUnimplementedTaxCalculatorServer()
+}
+
+func RegisterTaxCalculatorServer(s grpc.ServiceRegistrar, srv TaxCalculatorServer) {
+	// If the following call pancis, it indicates UnimplementedTaxCalculatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaxCalculator_ServiceDesc, srv)
+}
+
+func _TaxCalculator_ListTaxYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxCalculatorServer).ListTaxYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxCalculator_ListTaxYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxCalculatorServer).ListTaxYears(ctx, req.(*ListTaxYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxCalculator_GetTaxYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxCalculatorServer).GetTaxYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxCalculator_GetTaxYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxCalculatorServer).GetTaxYear(ctx, req.(*GetTaxYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxCalculator_Calculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxCalculatorServer).Calculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxCalculator_Calculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxCalculatorServer).Calculate(ctx, req.(*CalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaxCalculator_ServiceDesc is the grpc.ServiceDesc for TaxCalculator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaxCalculator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taxcalculator.v1.TaxCalculator",
+	HandlerType: (*TaxCalculatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTaxYears",
+			Handler:    _TaxCalculator_ListTaxYears_Handler,
+		},
+		{
+			MethodName: "GetTaxYear",
+			Handler:    _TaxCalculator_GetTaxYear_Handler,
+		},
+		{
+			MethodName: "Calculate",
+			Handler:    _TaxCalculator_Calculate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "taxcalculatorpb/tax_calculator.proto",
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+)
+
+// CalculateBonus calculates the incremental tax caused by a bonus and the withholding to take at source.
+func (s *TaxService) CalculateBonus(ctx context.Context, request api.CalculateBonusRequestObject) (api.CalculateBonusResponseObject, error) {
+	response, err := s.calculateBonus(request.Year, *request.Body)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.CalculateBonus404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.CalculateBonus400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.CalculateBonus200JSONResponse(response), nil
+}
+
+// calculateBonus calculates the tax on the salary with and without the bonus, using the same calculation input.
+func (s *TaxService) calculateBonus(year string, request api.BonusRequest) (api.BonusResponse, *Err) {
+	if request.Bonus <= 0 {
+		return api.BonusResponse{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "bonus",
+			Message: fmt.Sprintf("the bonus must be greater than 0. Invalid value: %.2f", request.Bonus),
+		}
+	}
+
+	input := api.CalculateRequest{
+		Salary:          request.Salary,
+		Jurisdiction:    request.Jurisdiction,
+		FilingStatus:    request.FilingStatus,
+		Province:        request.Province,
+		Deductions:      request.Deductions,
+		TaxWithheld:     request.TaxWithheld,
+		InstalmentsPaid: request.InstalmentsPaid,
+
+		ResidencyStartDate:   request.ResidencyStartDate,
+		ResidencyEndDate:     request.ResidencyEndDate,
+		NonResident:          request.NonResident,
+		CanadianSourceIncome: request.CanadianSourceIncome,
+		IncomeItems:          request.IncomeItems,
+	}
+	withoutBonus, err := s.calculate(year, input)
+	if err != nil {
+		return api.BonusResponse{}, err
+	}
+
+	input.Salary += request.Bonus
+	if input.NonResident {
+		// The bonus of a non-resident is paid for their Canadian employment.
+		input.CanadianSourceIncome += request.Bonus
+	}
+	withBonus, err := s.calculate(year, input)
+	if err != nil {
+		return api.BonusResponse{}, err
+	}
+
+	incrementalTax := roundCents(withBonus.TotalTaxOwed - withoutBonus.TotalTaxOwed)
+	incrementalContributions := roundCents(withBonus.TotalContributions - withoutBonus.TotalContributions)
+	withholding := roundCents(incrementalTax + incrementalContributions)
+	return api.BonusResponse{
+		TaxYear:                  withBonus.TaxYear,
+		Salary:                   request.Salary,
+		Bonus:                    request.Bonus,
+		IncrementalTax:           incrementalTax,
+		IncrementalContributions: incrementalContributions,
+		Withholding:              withholding,
+		NetBonus:                 roundCents(request.Bonus - withholding),
+		BonusTaxRate:             incrementalTax / request.Bonus,
+		WithoutBonus:             mapTaxOwedToAPICalculateResponse(withoutBonus),
+		WithBonus:                mapTaxOwedToAPICalculateResponse(withBonus),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"testing"
+)
+
+// TestCalculateBonus tests the calculateBonus function.
+func TestCalculateBonus(t *testing.T) {
+	s := NewTaxService()
+	var tests = []struct {
+		name                         string
+		year                         string
+		request                      api.BonusRequest
+		wantIncrementalTax           float64
+		wantIncrementalContributions float64
+		error                        int
+	}{
+		{
+			"federal only",
+			"2022",
+			api.BonusRequest{Salary: 50000, Bonus: 10000},
+			roundCents(CalculateTaxAmount("2022", TaxBrackets["2022"], 60000).TotalTaxOwed - 7500),
+			0,
+			0,
+		},
+		{
+			"contributions below the maximum earnings",
+			"2023",
+			api.BonusRequest{Salary: 40000, Bonus: 10000, Province: ProvinceOntario},
+			0,
+			roundCents(10000*0.0595 + 10000*0.0163),
+			0,
+		},
+		{
+			"contributions above the maximum earnings",
+			"2023",
+			api.BonusRequest{Salary: 100000, Bonus: 10000, Province: ProvinceOntario},
+			0,
+			0,
+			0,
+		},
+		{
+			"no bonus",
+			"2023",
+			api.BonusRequest{Salary: 100000},
+			0,
+			0,
+			http.StatusBadRequest,
+		},
+		{
+			"year not found",
+			"1999",
+			api.BonusRequest{Salary: 100000, Bonus: 10000},
+			0,
+			0,
+			http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ans, err := s.calculateBonus(tt.year, tt.request)
+			if err != nil {
+				if err.Code != tt.error {
+					t.Errorf("got error %v, want code %v", *err, tt.error)
+				}
+				return
+			}
+			if tt.error != 0 {
+				t.Fatalf("got no error, want code %v", tt.error)
+			}
+			if tt.wantIncrementalTax != 0 && ans.IncrementalTax != tt.wantIncrementalTax {
+				t.Errorf("got incremental tax %v, want %v", ans.IncrementalTax, tt.wantIncrementalTax)
+			}
+			if ans.IncrementalTax != roundCents(ans.WithBonus.TotalTaxOwed-ans.WithoutBonus.TotalTaxOwed) {
+				t.Errorf("got incremental tax %v, want the difference of %v and %v", ans.IncrementalTax, ans.WithBonus.TotalTaxOwed, ans.WithoutBonus.TotalTaxOwed)
+			}
+			if ans.IncrementalContributions != tt.wantIncrementalContributions {
+				t.Errorf("got incremental contributions %v, want %v", ans.IncrementalContributions, tt.wantIncrementalContributions)
+			}
+			if ans.NetBonus != roundCents(tt.request.Bonus-ans.IncrementalTax-ans.IncrementalContributions) {
+				t.Errorf("got net bonus %v, want the bonus less the withholding %v", ans.NetBonus, ans.Withholding)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Cache lifetimes of the responses built from the dataset, which only change with it.
+const (
+	// cacheMaxAge is the cache lifetime of a response for a year, or for every year.
+	cacheMaxAge = 24 * time.Hour
+	// symbolicYearCacheMaxAge is the cache lifetime of a response for a symbolic year, which resolves to the next
+	// year once its brackets are loaded or the clock reaches it.
+	symbolicYearCacheMaxAge = time.Hour
+)
+
+// DatasetLastModified is when the embedded dataset was last written by the import command.
+var DatasetLastModified, _ = time.Parse(time.RFC3339, FederalTaxYears.LastModified)
+
+// CacheHeaders represents the headers letting browsers and proxies cache a response built from the dataset and
+// revalidate it with If-None-Match or If-Modified-Since.
+type CacheHeaders struct {
+	ETag         string
+	CacheControl string
+	LastModified string
+	lastModified time.Time
+}
+
+// NewCacheHeaders returns the cache headers of a response for the requested year, resolved to year, in the content
+// type. The year is empty for the responses for every year.
+func NewCacheHeaders(requestedYear string, year string, contentType string) CacheHeaders {
+	return CacheHeaders{
+		ETag:         entityTag(year, contentType),
+		CacheControl: cacheControl(requestedYear, year),
+		LastModified: DatasetLastModified.UTC().Format(http.TimeFormat),
+		lastModified: DatasetLastModified,
+	}
+}
+
+// NotModified reports whether one of the ETags of the If-None-Match header is the ETag of the response or, without
+// If-None-Match, whether the dataset has not been modified since the date of the If-Modified-Since header, so it can be
+// answered with 304 Not Modified. An invalid If-Modified-Since date is ignored.
+func (h CacheHeaders) NotModified(ifNoneMatch *string, ifModifiedSince *string) bool {
+	if ifNoneMatch == nil {
+		if ifModifiedSince == nil {
+			return false
+		}
+		since, err := http.ParseTime(*ifModifiedSince)
+		// The HTTP dates have a precision of a second.
+		return err == nil && !h.lastModified.Truncate(time.Second).After(since)
+	}
+	for _, etag := range strings.Split(*ifNoneMatch, ",") {
+		// If-None-Match uses the weak comparison, which ignores the W/ prefix of the weak ETags.
+		etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+		if etag == "*" || etag == h.ETag {
+			return true
+		}
+	}
+	return false
+}
+
+// entityTag returns the strong ETag of a response from the dataset version, the year and the content type, e.g.
+// "275e0f6cc5225d01-2022-json", since the dataset is the only thing a response for a year changes with.
+func entityTag(year string, contentType string) string {
+	parts := []string{DatasetVersion}
+	if year != "" {
+		parts = append(parts, year)
+	}
+	_, subtype, _ := strings.Cut(contentType, "/")
+	parts = append(parts, subtype)
+	return `"` + strings.Join(parts, "-") + `"`
+}
+
+// cacheControl returns the Cache-Control of a response for the requested year, resolved to year.
+func cacheControl(requestedYear string, year string) string {
+	maxAge := cacheMaxAge
+	if requestedYear != year {
+		maxAge = symbolicYearCacheMaxAge
+	}
+	return fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+}
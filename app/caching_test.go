@@ -0,0 +1,121 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestNotModified tests the NotModified method of CacheHeaders.
+func TestNotModified(t *testing.T) {
+	cacheHeaders := NewCacheHeaders("2022", "2022", ContentTypeJSON)
+	etag := `"` + DatasetVersion + `-2022-json"`
+	if cacheHeaders.ETag != etag {
+		t.Fatalf("got ETag %v, want %v", cacheHeaders.ETag, etag)
+	}
+	lastModified := cacheHeaders.LastModified
+	before := DatasetLastModified.Add(-time.Hour).UTC().Format(http.TimeFormat)
+	var tests = []struct {
+		name            string
+		ifNoneMatch     *string
+		ifModifiedSince *string
+		want            bool
+	}{
+		{"no header", nil, nil, false},
+		{"same", &etag, nil, true},
+		{"weak", optionalString("W/" + etag), nil, true},
+		{"list", optionalString(`"other", ` + etag), nil, true},
+		{"any", optionalString("*"), nil, true},
+		{"other content type", optionalString(`"` + DatasetVersion + `-2022-csv"`), nil, false},
+		{"other dataset", optionalString(`"0000000000000000-2022-json"`), nil, false},
+		{"not modified since", nil, &lastModified, true},
+		{"modified since", nil, &before, false},
+		{"invalid date", nil, optionalString("yesterday"), false},
+		{"If-None-Match first", optionalString(`"0000000000000000-2022-json"`), &lastModified, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ans := cacheHeaders.NotModified(tt.ifNoneMatch, tt.ifModifiedSince); ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+// TestDatasetLastModified tests that the last modification is read from the dataset and validated.
+func TestDatasetLastModified(t *testing.T) {
+	if ans := DatasetLastModified.UTC().Format(time.RFC3339); ans != FederalTaxYears.LastModified {
+		t.Errorf("got %v, want the last modification of the dataset %v", ans, FederalTaxYears.LastModified)
+	}
+	if _, err := LoadTaxBracketDataset([]byte(`{"last_modified": "2023-05-02", "years": []}`)); err == nil {
+		t.Errorf("got no error for a last modification without a time, want error")
+	}
+}
+
+// TestConditionalRequests tests that the bracket endpoints return their cache headers and answer 304 Not Modified
+// when the If-None-Match header has their ETag, or the If-Modified-Since header their last modification.
+func TestConditionalRequests(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		path         string
+		accept       string
+		cacheControl string
+	}{
+		{"/tax-calculator/", "", "public, max-age=3600"},
+		{"/tax-calculator/tax-years", "", "public, max-age=86400"},
+		{"/tax-calculator/tax-years/2022", "", "public, max-age=86400"},
+		{"/tax-calculator/tax-years/2022", "text/csv", "public, max-age=86400"},
+		{"/tax-calculator/tax-years/latest.yaml", "", "public, max-age=3600"},
+		{"/tax-calculator/v2/tax-years", "", "public, max-age=86400"},
+		{"/tax-calculator/v2/tax-years/2022", "", "public, max-age=86400"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path+"/"+tt.accept, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Accept", tt.accept)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			etag := rec.Header().Get("ETag")
+			if etag == "" {
+				t.Fatalf("got no ETag, want one")
+			}
+			if cacheControl := rec.Header().Get("Cache-Control"); cacheControl != tt.cacheControl {
+				t.Errorf("got Cache-Control %v, want %v", cacheControl, tt.cacheControl)
+			}
+			if lastModified := rec.Header().Get("Last-Modified"); lastModified != DatasetLastModified.UTC().Format(http.TimeFormat) {
+				t.Errorf("got Last-Modified %v, want %v", lastModified, DatasetLastModified.UTC().Format(http.TimeFormat))
+			}
+
+			req.Header.Set("If-None-Match", etag)
+			rec = httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusNotModified {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusNotModified, rec.Body.String())
+			}
+			if rec.Body.Len() != 0 {
+				t.Errorf("got body %v, want none", rec.Body.String())
+			}
+			if got := rec.Header().Get("ETag"); got != etag {
+				t.Errorf("got ETag %v, want %v", got, etag)
+			}
+			if cacheControl := rec.Header().Get("Cache-Control"); cacheControl != tt.cacheControl {
+				t.Errorf("got Cache-Control %v, want %v", cacheControl, tt.cacheControl)
+			}
+
+			req.Header.Del("If-None-Match")
+			req.Header.Set("If-Modified-Since", DatasetLastModified.UTC().Format(http.TimeFormat))
+			rec = httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusNotModified {
+				t.Errorf("got status %v with If-Modified-Since, want %v", rec.Code, http.StatusNotModified)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CurrencyCAD is the currency the exchange rates are quoted in.
+const CurrencyCAD = "CAD"
+
+// JurisdictionCurrencies represents the currency of every jurisdiction.
+var JurisdictionCurrencies = map[string]string{
+	JurisdictionCanada: CurrencyCAD,
+	JurisdictionUS:     "USD",
+}
+
+//go:embed data/exchange_rates.csv
+var exchangeRatesCSV string
+
+// ExchangeRates represents the Bank of Canada annual average exchange rates, in CAD per unit of the
+// currency, for all supported years.
+var ExchangeRates = mustLoadExchangeRates(exchangeRatesCSV)
+
+// IncomeItem represents an income item converted to the currency of the jurisdiction.
+type IncomeItem struct {
+	Description     string  `json:"description"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	ExchangeRate    float64 `json:"exchange_rate"`
+	ConvertedAmount float64 `json:"converted_amount"`
+}
+
+// LoadExchangeRatesCSV loads the exchange rates from a CSV with a year, currency and rate header.
+func LoadExchangeRatesCSV(r io.Reader) (map[string]map[string]float64, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading exchange rates: %w", err)
+	}
+	if len(records) == 0 || strings.Join(records[0], ",") != "year,currency,rate" {
+		return nil, fmt.Errorf("error reading exchange rates: the header must be year,currency,rate")
+	}
+	exchangeRates := map[string]map[string]float64{}
+	for i, record := range records[1:] {
+		year, currency := record[0], strings.ToUpper(record[1])
+		if err := ValidateYear(year); err != nil {
+			return nil, fmt.Errorf("error reading exchange rates on line %d: %v", i+2, err.Message)
+		}
+		rate, err := strconv.ParseFloat(record[2], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("error reading exchange rates on line %d: the rate %v is not a positive number", i+2, record[2])
+		}
+		if exchangeRates[year] == nil {
+			exchangeRates[year] = map[string]float64{}
+		}
+		exchangeRates[year][currency] = rate
+	}
+	return exchangeRates, nil
+}
+
+// mustLoadExchangeRates loads the embedded exchange rates and panics if they are invalid.
+func mustLoadExchangeRates(data string) map[string]map[string]float64 {
+	exchangeRates, err := LoadExchangeRatesCSV(strings.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return exchangeRates
+}
+
+// GetExchangeRate returns the annual average exchange rate of the year from a currency to another.
+func GetExchangeRate(year string, from string, to string) (float64, *Err) {
+	rates := ExchangeRates[year]
+	rate := func(currency string) (float64, *Err) {
+		if currency == CurrencyCAD {
+			return 1, nil
+		}
+		if rate, ok := rates[currency]; ok {
+			return rate, nil
+		}
+		return 0, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "currency",
+			Message: fmt.Sprintf("the exchange rate of the currency '%v' for the tax year '%v' is not found", currency, year),
+		}
+	}
+	fromRate, err := rate(from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := rate(to)
+	if err != nil {
+		return 0, err
+	}
+	return fromRate / toRate, nil
+}
+
+// ConvertIncomeItem converts an income item of the year to the currency of the jurisdiction.
+// An item without a currency is in the currency of the jurisdiction.
+func ConvertIncomeItem(year string, jurisdiction string, description string, amount float64, currency string) (IncomeItem, *Err) {
+	to := JurisdictionCurrencies[jurisdiction]
+	currency = strings.ToUpper(currency)
+	if currency == "" {
+		currency = to
+	}
+	if amount < 0 {
+		return IncomeItem{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "amount",
+			Message: fmt.Sprintf("the income amount must be greater than 0. Invalid value: %.2f", amount),
+		}
+	}
+	rate := 1.0
+	if currency != to {
+		var err *Err
+		rate, err = GetExchangeRate(year, currency, to)
+		if err != nil {
+			return IncomeItem{}, err
+		}
+	}
+	return IncomeItem{
+		Description:     description,
+		Amount:          amount,
+		Currency:        currency,
+		ExchangeRate:    rate,
+		ConvertedAmount: roundCents(amount * rate),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"strings"
+	"testing"
+)
+
+// TestLoadExchangeRatesCSV tests the LoadExchangeRatesCSV function.
+func TestLoadExchangeRatesCSV(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input string
+		want  float64
+		error bool
+	}{
+		{"valid", "year,currency,rate\n2023,usd,1.3497\n", 1.3497, false},
+		{"missing header", "2023,USD,1.3497\n", 0, true},
+		{"invalid year", "year,currency,rate\n20x3,USD,1.3497\n", 0, true},
+		{"negative rate", "year,currency,rate\n2023,USD,-1\n", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ans, err := LoadExchangeRatesCSV(strings.NewReader(tt.input))
+			if (err != nil) != tt.error {
+				t.Fatalf("got error %v, want error %v", err, tt.error)
+			}
+			if err == nil && ans["2023"]["USD"] != tt.want {
+				t.Errorf("got %v, want %v", ans["2023"]["USD"], tt.want)
+			}
+		})
+	}
+}
+
+// TestConvertIncomeItem tests the ConvertIncomeItem function.
+func TestConvertIncomeItem(t *testing.T) {
+	var tests = []struct {
+		name         string
+		jurisdiction string
+		amount       float64
+		currency     string
+		want         float64
+		error        int
+	}{
+		{"same currency", JurisdictionCanada, 1000, "", 1000, 0},
+		{"USD to CAD", JurisdictionCanada, 1000, "USD", 1349.7, 0},
+		{"lowercase currency", JurisdictionCanada, 1000, "eur", 1459.7, 0},
+		{"EUR to USD", JurisdictionUS, 1000, "EUR", 1081.5, 0},
+		{"unknown currency", JurisdictionCanada, 1000, "XYZ", 0, http.StatusBadRequest},
+		{"negative amount", JurisdictionCanada, -1, "USD", 0, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ans, err := ConvertIncomeItem("2023", tt.jurisdiction, "bonus", tt.amount, tt.currency)
+			if err != nil {
+				if err.Code != tt.error {
+					t.Errorf("got error %v, want code %v", *err, tt.error)
+				}
+				return
+			}
+			if tt.error != 0 {
+				t.Fatalf("got no error, want code %v", tt.error)
+			}
+			if ans.ConvertedAmount != tt.want {
+				t.Errorf("got %v, want %v", ans.ConvertedAmount, tt.want)
+			}
+		})
+	}
+}
+
+// TestCalculateForeignIncome tests that converted income items are added to the taxable income.
+func TestCalculateForeignIncome(t *testing.T) {
+	s := NewTaxService()
+	taxOwed, err := s.calculate("2022", api.CalculateRequest{
+		Salary:      40000,
+		IncomeItems: []api.IncomeItemInput{{Description: "US consulting", Amount: 10000, Currency: "USD"}},
+	})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	want := CalculateTaxAmount("2022", TaxBrackets["2022"], 53013)
+	if taxOwed.TotalIncome != 53013 || taxOwed.TaxableIncome != 53013 {
+		t.Errorf("got total income %v and taxable income %v, want 53013", taxOwed.TotalIncome, taxOwed.TaxableIncome)
+	}
+	if taxOwed.TotalTaxOwed != want.TotalTaxOwed {
+		t.Errorf("got total tax owed %v, want %v", taxOwed.TotalTaxOwed, want.TotalTaxOwed)
+	}
+	if len(taxOwed.IncomeItems) != 1 || taxOwed.IncomeItems[0].ExchangeRate != 1.3013 {
+		t.Errorf("got income items %v, want the 2022 USD rate 1.3013", taxOwed.IncomeItems)
+	}
+
+	_, err = s.calculate("2022", api.CalculateRequest{
+		Salary:      40000,
+		IncomeItems: []api.IncomeItemInput{{Amount: 10000, Currency: "JPY"}},
+	})
+	if err == nil || err.Field != "income_items[0].currency" {
+		t.Errorf("got error %v, want an error on income_items[0].currency", err)
+	}
+}
@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	chimiddleware2 "github.com/go-chi/chi/v5/middleware"
+	"gopkg.in/yaml.v3"
+)
+
+// Content types the bracket and calculation endpoints can respond with.
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeCSV  = "text/csv"
+	ContentTypeXML  = "application/xml"
+	ContentTypeYAML = "application/yaml"
+	ContentTypePDF  = "application/pdf"
+)
+
+// encodedContentTypes are the content types EncodeResponse encodes the responses in.
+var encodedContentTypes = []string{ContentTypeJSON, ContentTypeCSV, ContentTypeXML, ContentTypeYAML}
+
+// mediaTypes represents the content type of every supported media type of the Accept header.
+var mediaTypes = map[string]string{
+	"application/json":   ContentTypeJSON,
+	"text/csv":           ContentTypeCSV,
+	"application/xml":    ContentTypeXML,
+	"text/xml":           ContentTypeXML,
+	"application/yaml":   ContentTypeYAML,
+	"application/x-yaml": ContentTypeYAML,
+	"text/yaml":          ContentTypeYAML,
+	"application/pdf":    ContentTypePDF,
+	"application/*":      ContentTypeJSON,
+	"*/*":                ContentTypeJSON,
+}
+
+// urlFormats represents the content type of every supported file extension of the URL.
+var urlFormats = map[string]string{
+	"json": ContentTypeJSON,
+	"csv":  ContentTypeCSV,
+	"xml":  ContentTypeXML,
+	"yaml": ContentTypeYAML,
+	"yml":  ContentTypeYAML,
+	"pdf":  ContentTypePDF,
+}
+
+// contentTypeCtxKey is the context key of the negotiated content type.
+type contentTypeCtxKey struct{}
+
+// NewContentNegotiationMiddleware returns a middleware storing the content types accepted for the response in the
+// context of the request.
+func NewContentNegotiationMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), contentTypeCtxKey{}, AcceptedContentTypes(r))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AcceptedContentTypes returns the content type of the file extension of the URL, e.g. /tax-years/2022.csv, or else
+// the supported media types of the Accept header, from the most preferred.
+func AcceptedContentTypes(r *http.Request) []string {
+	if format, _ := r.Context().Value(chimiddleware2.URLFormatCtxKey).(string); format != "" {
+		if contentType, ok := urlFormats[strings.ToLower(format)]; ok {
+			return []string{contentType}
+		}
+	}
+
+	type mediaRange struct {
+		contentType string
+		quality     float64
+	}
+	var ranges []mediaRange
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		contentType, ok := mediaTypes[mediaType]
+		if !ok {
+			continue
+		}
+		quality := 1.0
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			quality = q
+		}
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{contentType, quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	contentTypes := make([]string, len(ranges))
+	for i, accepted := range ranges {
+		contentTypes[i] = accepted.contentType
+	}
+	return contentTypes
+}
+
+// NegotiatedContentType returns the most preferred accepted content type the endpoint supports, or JSON.
+func NegotiatedContentType(ctx context.Context, supported ...string) string {
+	accepted, _ := ctx.Value(contentTypeCtxKey{}).([]string)
+	for _, contentType := range accepted {
+		for _, s := range supported {
+			if contentType == s {
+				return contentType
+			}
+		}
+	}
+	return ContentTypeJSON
+}
+
+// Representation describes how a response is encoded in the formats other than JSON.
+type Representation struct {
+	// Root is the name of the root element of the XML document, e.g. calculation.
+	Root string
+	// Key names the keys of an object of arrays, e.g. year for the tax brackets of every year. It is the CSV
+	// column and the XML element of the keys.
+	Key string
+}
+
+// keyName returns the name of the keys of an object of arrays, or key.
+func (r Representation) keyName() string {
+	if r.Key == "" {
+		return "key"
+	}
+	return r.Key
+}
+
+// Representations of the bracket and calculation responses.
+var (
+	taxBracketsRepresentation = Representation{Root: "tax_brackets"}
+	taxYearRepresentation     = Representation{Root: "tax_year"}
+	taxYearsRepresentation    = Representation{Root: "tax_years", Key: "year"}
+	calculationRepresentation = Representation{Root: "calculation"}
+)
+
+// EncodedResponse represents a response encoded in a format other than JSON.
+type EncodedResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+// encodedOperationResponse represents the responses of an operation in a format other than JSON, which share the
+// encoded body and the headers of its JSON response.
+type encodedOperationResponse[Headers any] interface {
+	~struct {
+		Body          io.Reader
+		Headers       Headers
+		ContentLength int64
+	}
+}
+
+// newEncodedOperationResponse returns the response of an operation with the encoded body and the headers of its JSON
+// response, which converts to the response of the negotiated content type.
+func newEncodedOperationResponse[Response encodedOperationResponse[Headers], Headers any](encoded EncodedResponse, headers Headers) Response {
+	return Response{Body: encoded.Body, Headers: headers, ContentLength: encoded.ContentLength}
+}
+
+// EncodeResponse encodes the JSON response in the content type, with the same fields.
+func EncodeResponse(contentType string, representation Representation, response any) (EncodedResponse, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return EncodedResponse{}, err
+	}
+	var buf bytes.Buffer
+	switch contentType {
+	case ContentTypeCSV:
+		value, err := decodeOrdered(data)
+		if err == nil {
+			err = writeCSV(&buf, representation, value)
+		}
+		if err != nil {
+			return EncodedResponse{}, err
+		}
+	case ContentTypeXML:
+		value, err := decodeOrdered(data)
+		if err == nil {
+			err = writeXML(&buf, representation, value)
+		}
+		if err != nil {
+			return EncodedResponse{}, err
+		}
+	case ContentTypeYAML:
+		if err := writeYAML(&buf, data); err != nil {
+			return EncodedResponse{}, err
+		}
+	default:
+		buf.Write(data)
+	}
+	return EncodedResponse{Body: &buf, ContentLength: int64(buf.Len())}, nil
+}
+
+// orderedField is a field of a JSON object, which keeps the fields in the order of the document.
+type orderedField struct {
+	Key   string
+	Value any
+}
+
+// orderedObject is a JSON object decoded by decodeOrdered.
+type orderedObject []orderedField
+
+// decodeOrdered decodes a JSON document into ordered objects, arrays, json.Number, string, bool and nil values.
+func decodeOrdered(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decodeOrderedValue(decoder)
+}
+
+// decodeOrderedValue decodes the next JSON value of the decoder.
+func decodeOrderedValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		object := orderedObject{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrderedValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, orderedField{Key: key.(string), Value: value})
+		}
+		_, err := decoder.Token()
+		return object, err
+	case json.Delim('['):
+		array := []any{}
+		for decoder.More() {
+			value, err := decodeOrderedValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err := decoder.Token()
+		return array, err
+	}
+	return token, nil
+}
+
+// writeCSV writes an array of objects as a table with a row per object, and an object whose fields are all arrays
+// of objects as one table with the key of every row in the first column. Any other value is written as a table of
+// field and value rows, with the nested fields formatted like income_items[0].currency.
+func writeCSV(w io.Writer, representation Representation, value any) error {
+	var header []string
+	var rows []map[string]string
+	switch v := value.(type) {
+	case []any:
+		if !isTable(v) {
+			return writeFieldValueCSV(w, value)
+		}
+		for _, item := range v {
+			rows = append(rows, flatten(item))
+		}
+	case orderedObject:
+		if len(v) == 0 {
+			return writeFieldValueCSV(w, value)
+		}
+		header = []string{representation.keyName()}
+		for _, field := range v {
+			items, ok := field.Value.([]any)
+			if !ok || !isTable(items) {
+				return writeFieldValueCSV(w, value)
+			}
+			for _, item := range items {
+				row := flatten(item)
+				row[representation.keyName()] = field.Key
+				rows = append(rows, row)
+			}
+		}
+	default:
+		return writeFieldValueCSV(w, value)
+	}
+
+	seen := map[string]bool{}
+	for _, column := range header {
+		seen[column] = true
+	}
+	for _, item := range flattenedKeys(value) {
+		if !seen[item] {
+			seen[item] = true
+			header = append(header, item)
+		}
+	}
+	writer := csv.NewWriter(w)
+	writer.Write(header)
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, column := range header {
+			record[i] = row[column]
+		}
+		writer.Write(record)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeFieldValueCSV writes the value as a table of field and value rows.
+func writeFieldValueCSV(w io.Writer, value any) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"field", "value"})
+	flattenInto(nil, value, func(path string, scalar string) {
+		writer.Write([]string{path, scalar})
+	})
+	writer.Flush()
+	return writer.Error()
+}
+
+// isTable returns whether every item of the array is an object.
+func isTable(items []any) bool {
+	for _, item := range items {
+		if _, ok := item.(orderedObject); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// flatten returns the scalar values of the value by path.
+func flatten(value any) map[string]string {
+	row := map[string]string{}
+	flattenInto(nil, value, func(path string, scalar string) {
+		row[path] = scalar
+	})
+	return row
+}
+
+// flattenedKeys returns the paths of the objects of a table, in the order they first appear.
+func flattenedKeys(value any) []string {
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case orderedObject:
+		for _, field := range v {
+			items = append(items, field.Value.([]any)...)
+		}
+	}
+	var keys []string
+	seen := map[string]bool{}
+	for _, item := range items {
+		flattenInto(nil, item, func(path string, scalar string) {
+			if !seen[path] {
+				seen[path] = true
+				keys = append(keys, path)
+			}
+		})
+	}
+	return keys
+}
+
+// flattenInto calls emit with the path and the formatted value of every scalar of the value.
+func flattenInto(pointer []string, value any, emit func(path string, scalar string)) {
+	switch v := value.(type) {
+	case orderedObject:
+		for _, field := range v {
+			flattenInto(append(pointer, field.Key), field.Value, emit)
+		}
+	case []any:
+		for i, item := range v {
+			flattenInto(append(pointer, strconv.Itoa(i)), item, emit)
+		}
+	default:
+		emit(fieldPath(pointer), formatScalar(value))
+	}
+}
+
+// formatScalar formats a scalar JSON value, with null as an empty string.
+func formatScalar(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// writeXML writes the value as an XML document, with an element per field named after the field, or after the key
+// of the representation, with a key attribute, when the field is not a valid element name, e.g. a year. The items
+// of an array are item elements.
+func writeXML(w io.Writer, representation Representation, value any) error {
+	io.WriteString(w, xml.Header)
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encodeXMLElement(encoder, representation, xml.StartElement{Name: xml.Name{Local: representation.Root}}, value); err != nil {
+		return err
+	}
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// encodeXMLElement encodes the value as the element.
+func encodeXMLElement(encoder *xml.Encoder, representation Representation, start xml.StartElement, value any) error {
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case orderedObject:
+		for _, field := range v {
+			child := xml.StartElement{Name: xml.Name{Local: field.Key}}
+			if !isXMLName(field.Key) {
+				child = xml.StartElement{
+					Name: xml.Name{Local: representation.keyName()},
+					Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: field.Key}},
+				}
+			}
+			if err := encodeXMLElement(encoder, representation, child, field.Value); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if err := encodeXMLElement(encoder, representation, xml.StartElement{Name: xml.Name{Local: "item"}}, item); err != nil {
+				return err
+			}
+		}
+	default:
+		if err := encoder.EncodeToken(xml.CharData(formatScalar(value))); err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+// isXMLName returns whether the name is a valid XML element name made of letters, digits and underscores.
+func isXMLName(name string) bool {
+	if name == "" || strings.HasPrefix(strings.ToLower(name), "xml") {
+		return false
+	}
+	for i, r := range name {
+		letter := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !letter && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// writeYAML writes the JSON document as a block style YAML document, keeping the order of the fields.
+func writeYAML(w io.Writer, data []byte) error {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+	clearYAMLStyle(&document)
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// clearYAMLStyle clears the flow and quoting style the JSON document was parsed with.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"patrickyau/interview-test-server/api"
+	"strings"
+	"testing"
+
+	chimiddleware2 "github.com/go-chi/chi/v5/middleware"
+)
+
+// TestNegotiatedContentType tests the AcceptedContentTypes and NegotiatedContentType functions.
+func TestNegotiatedContentType(t *testing.T) {
+	withPDF := append(encodedContentTypes, ContentTypePDF)
+	var tests = []struct {
+		name      string
+		urlFormat string
+		accept    string
+		supported []string
+		want      string
+	}{
+		{"no accept", "", "", encodedContentTypes, ContentTypeJSON},
+		{"csv", "", "text/csv", encodedContentTypes, ContentTypeCSV},
+		{"xml", "", "text/xml", encodedContentTypes, ContentTypeXML},
+		{"yaml", "", "application/x-yaml", encodedContentTypes, ContentTypeYAML},
+		{"quality", "", "application/json;q=0.5, text/csv", encodedContentTypes, ContentTypeCSV},
+		{"unsupported", "", "text/html, application/yaml;q=0.9, */*;q=0.1", encodedContentTypes, ContentTypeYAML},
+		{"nothing supported", "", "text/html", encodedContentTypes, ContentTypeJSON},
+		{"refused", "", "text/csv;q=0, */*", encodedContentTypes, ContentTypeJSON},
+		{"extension", "xml", "text/csv", encodedContentTypes, ContentTypeXML},
+		{"unknown extension", "txt", "text/csv", encodedContentTypes, ContentTypeCSV},
+		{"pdf", "", "application/pdf, text/csv;q=0.5", withPDF, ContentTypePDF},
+		{"pdf not supported", "", "application/pdf, text/csv;q=0.5", encodedContentTypes, ContentTypeCSV},
+		{"pdf extension not supported", "pdf", "", encodedContentTypes, ContentTypeJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tax-calculator/tax-years/2022", nil)
+			req = req.WithContext(context.WithValue(req.Context(), chimiddleware2.URLFormatCtxKey, tt.urlFormat))
+			req.Header.Set("Accept", tt.accept)
+			ctx := context.WithValue(req.Context(), contentTypeCtxKey{}, AcceptedContentTypes(req))
+			if ans := NegotiatedContentType(ctx, tt.supported...); ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+// TestEncodeResponse tests that the responses are encoded with the same fields as JSON.
+func TestEncodeResponse(t *testing.T) {
+	brackets := []api.TaxBracket{{Min: 0, Max: 50197, Rate: 0.15}, {Min: 50197, Rate: 0.205}}
+	calculation := api.CalculateResponse{
+		TaxYear:        "2022",
+		Salary:         60000,
+		TaxOwedPerBand: brackets,
+	}
+	var tests = []struct {
+		name           string
+		contentType    string
+		representation Representation
+		response       any
+		want           string
+	}{
+		{
+			"csv table",
+			ContentTypeCSV,
+			taxBracketsRepresentation,
+			brackets,
+			"max,min,rate\n50197,0,0.15\n0,50197,0.205\n",
+		},
+		{
+			"csv table by key",
+			ContentTypeCSV,
+			taxYearsRepresentation,
+			api.AllTaxBracketResponses{"2022": brackets[:1]},
+			"year,max,min,rate\n2022,50197,0,0.15\n",
+		},
+		{
+			"csv fields",
+			ContentTypeCSV,
+			calculationRepresentation,
+			api.CalculateResponse{TaxYear: "2022", TaxOwedPerBand: brackets[:1]},
+			"field,value\neffective_tax_rate,\nsalary,0\ntax_owed_per_band[0].max,50197\ntax_owed_per_band[0].min,0\ntax_owed_per_band[0].rate,0.15\ntax_year,2022\ntotal_tax_owed,0\n",
+		},
+		{
+			"xml",
+			ContentTypeXML,
+			taxYearsRepresentation,
+			api.AllTaxBracketResponses{"2022": brackets[:1]},
+			"<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<tax_years>\n  <year key=\"2022\">\n    <item>\n      <max>50197</max>\n      <min>0</min>\n      <rate>0.15</rate>\n    </item>\n  </year>\n</tax_years>\n",
+		},
+		{
+			"yaml",
+			ContentTypeYAML,
+			calculationRepresentation,
+			calculation,
+			"effective_tax_rate: \"\"\nsalary: 60000\ntax_owed_per_band:\n  - max: 50197\n    min: 0\n    rate: 0.15\n  - max: 0\n    min: 50197\n    rate: 0.205\ntax_year: \"2022\"\ntotal_tax_owed: 0\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := EncodeResponse(tt.contentType, tt.representation, tt.response)
+			if err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			body, _ := io.ReadAll(encoded.Body)
+			if string(body) != tt.want {
+				t.Errorf("got\n%v\nwant\n%v", string(body), tt.want)
+			}
+			if encoded.ContentLength != int64(len(body)) {
+				t.Errorf("got content length %v, want %v", encoded.ContentLength, len(body))
+			}
+		})
+	}
+}
+
+// TestContentNegotiation tests that the bracket and calculation endpoints honour the Accept header and the file
+// extension of the URL.
+func TestContentNegotiation(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		method string
+		path   string
+		accept string
+		want   string
+		prefix string
+	}{
+		{http.MethodGet, "/tax-calculator/", "text/csv", ContentTypeCSV, "max,min,rate\n"},
+		{http.MethodGet, "/tax-calculator/tax-years/2022.xml", "", ContentTypeXML, "<?xml"},
+		{http.MethodGet, "/tax-calculator/tax-years/2022", "application/yaml", ContentTypeYAML, "brackets:\n"},
+		{http.MethodGet, "/tax-calculator/tax-years.csv", "", ContentTypeCSV, "year,max,min,rate\n2000,"},
+		{http.MethodPost, "/tax-calculator/tax-years/2022/calculate.csv", "", ContentTypeCSV, "field,value\n"},
+		{http.MethodPost, "/tax-calculator/tax-years/2022/calculate", "", ContentTypeJSON, "{"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path+"/"+tt.accept, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.method == http.MethodPost {
+				req = httptest.NewRequest(tt.method, tt.path, strings.NewReader(`{"salary": 60000}`))
+				req.Header.Set("Content-Type", "application/json")
+			}
+			req.Header.Set("Accept", tt.accept)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			if contentType := rec.Header().Get("Content-Type"); contentType != tt.want {
+				t.Errorf("got content type %v, want %v", contentType, tt.want)
+			}
+			if !strings.HasPrefix(rec.Body.String(), tt.prefix) {
+				t.Errorf("got body %v, want it to start with %v", rec.Body.String(), tt.prefix)
+			}
+		})
+	}
+}
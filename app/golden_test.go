@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"patrickyau/interview-test-server/api"
+	"strings"
+	"testing"
+)
+
+// update regenerates the golden files when the data or the calculation intentionally changes:
+//
+//	go test ./app -run 'TestCalculateTaxAmount|TestCalculateHandlerGolden' -update
+var update = flag.Bool("update", false, "regenerate the golden files in testdata/golden")
+
+// goldenCases represents the salaries calculated for every year of the golden regression suite.
+type goldenCases struct {
+	Years    []string  `json:"years"`
+	Salaries []float64 `json:"salaries"`
+}
+
+// readGoldenCases reads the golden cases from testdata/golden/cases.json.
+func readGoldenCases(t *testing.T) goldenCases {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "golden", "cases.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases goldenCases
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatal(err)
+	}
+	return cases
+}
+
+// goldenDatasetVersion replaces the dataset version in the golden files, which would otherwise change with any data.
+const goldenDatasetVersion = "<dataset_version>"
+
+// assertGolden compares the results with the golden file testdata/golden/<name>.json, or writes it with -update.
+func assertGolden(t *testing.T, name string, results any) {
+	t.Helper()
+	got, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = bytes.ReplaceAll(got, []byte(`"`+DatasetVersion+`"`), []byte(`"`+goldenDatasetVersion+`"`))
+	got = append(got, '\n')
+	path := filepath.Join("testdata", "golden", name+".json")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v, run the tests with -update to create it", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("the results differ from %v, run the tests with -update if the change is intended:\n%v", path, diffLines(string(want), string(got)))
+	}
+}
+
+// diffLines returns the first lines that differ between want and got.
+func diffLines(want string, got string) string {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	var diff []string
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			diff = append(diff, fmt.Sprintf("line %d:\n- %v\n+ %v", i+1, w, g))
+		}
+		if len(diff) == 10 {
+			break
+		}
+	}
+	return strings.Join(diff, "\n")
+}
+
+// TestCalculateHandlerGolden tests the HTTP Calculate handler against the golden files.
+func TestCalculateHandlerGolden(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := readGoldenCases(t)
+	for _, year := range cases.Years {
+		t.Run(year, func(t *testing.T) {
+			var results []any
+			for _, salary := range cases.Salaries {
+				body := fmt.Sprintf(`{"salary": %v}`, salary)
+				req := httptest.NewRequest(http.MethodPost, "/tax-calculator/tax-years/"+year+"/calculate", strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, req)
+				if rec.Code != http.StatusOK {
+					t.Fatalf("got status %v for the salary %v, want 200: %v", rec.Code, salary, rec.Body)
+				}
+				var result any
+				if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+					t.Fatal(err)
+				}
+				results = append(results, result)
+			}
+			assertGolden(t, filepath.Join("http_calculate", year), results)
+		})
+	}
+}
+
+// TestReadmeScenarios tests the 2022 scenarios and the 2023 sample response of the README, which are the tax of the
+// federal brackets, with CalculateTaxAmount and the calculation of the Calculate endpoint.
+func TestReadmeScenarios(t *testing.T) {
+	var tests = []struct {
+		year          string
+		salary        float64
+		want          float64
+		effectiveRate string
+	}{
+		{"2022", 0, 0, "0.00%"},
+		{"2022", 50000, 7500, "15.00%"},
+		{"2022", 100000, 17739.17, "17.74%"},
+		{"2022", 1234567, 385587.65, "31.23%"},
+		{"2023", 1234567, 384213.03, "31.12%"},
+	}
+	s := NewTaxService()
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v/%v", tt.year, tt.salary), func(t *testing.T) {
+			ans := CalculateTaxAmount(tt.year, TaxBrackets[tt.year], tt.salary)
+			if ans.TotalTaxOwed != tt.want || ans.EffectiveTaxRate != tt.effectiveRate {
+				t.Errorf("got %v at %v, want %v at %v", ans.TotalTaxOwed, ans.EffectiveTaxRate, tt.want, tt.effectiveRate)
+			}
+			taxOwed, err := s.calculate(tt.year, api.CalculateRequest{Salary: tt.salary})
+			if err != nil {
+				t.Fatalf("got error %v, want nil", *err)
+			}
+			if taxOwed.TotalTaxOwed != tt.want || taxOwed.EffectiveTaxRate != tt.effectiveRate {
+				t.Errorf("got %v at %v from the endpoint, want %v at %v", taxOwed.TotalTaxOwed, taxOwed.EffectiveTaxRate, tt.want, tt.effectiveRate)
+			}
+		})
+	}
+}
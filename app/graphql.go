@@ -0,0 +1,589 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Limits of the GraphQL queries, checked before they are executed. The introspection fields, e.g. __schema, count
+// towards the complexity, but their nested fields have a depth limit of their own, so GraphiQL can load the schema.
+const (
+	// GraphQLMaxDepth is the maximum number of nested fields of a query.
+	GraphQLMaxDepth = 8
+	// GraphQLMaxIntrospectionDepth is the maximum number of nested fields of a query through an introspection field,
+	// deep enough for the nested ofType fields of the introspection query of GraphiQL.
+	GraphQLMaxIntrospectionDepth = 16
+	// GraphQLMaxComplexity is the maximum cost of a query, where every field costs 1, a calculation costs
+	// graphQLCalculateCost, and the fields of tax_years count once per year.
+	GraphQLMaxComplexity = 1000
+	// graphQLCalculateCost is the cost of the calculate field.
+	graphQLCalculateCost = 25
+	// graphQLMaxBodySize is the maximum size of the body of a GraphQL request.
+	graphQLMaxBodySize = 1 << 20
+)
+
+// GraphQLRequest represents a GraphQL request, sent as JSON or in the query string.
+type GraphQLRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// GraphQLHandler serves the GraphQL queries over the tax brackets and the calculations of the TaxService.
+type GraphQLHandler struct {
+	schema graphql.Schema
+	// graphiQL enables the GraphiQL page, for development.
+	graphiQL bool
+}
+
+// NewGraphQLHandler returns the handler of the GraphQL endpoint, serving the GraphiQL page to browsers when graphiQL
+// is set.
+func NewGraphQLHandler(service *TaxService, graphiQL bool) (*GraphQLHandler, error) {
+	schema, err := NewGraphQLSchema(service)
+	if err != nil {
+		return nil, err
+	}
+	return &GraphQLHandler{schema: schema, graphiQL: graphiQL}, nil
+}
+
+// ServeHTTP executes the query of a POST request with a JSON body or of a GET request with a query string.
+func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request GraphQLRequest
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		if h.graphiQL && !query.Has("query") && strings.Contains(r.Header.Get("Accept"), "text/html") {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(graphiQLPage))
+			return
+		}
+		request.Query = query.Get("query")
+		request.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				WriteProblem(w, r, &Err{
+					Code:    http.StatusBadRequest,
+					Field:   "variables",
+					Message: fmt.Sprintf("the variables are not a JSON object: %v", err),
+				})
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, graphQLMaxBodySize)).Decode(&request); err != nil {
+			WriteProblem(w, r, &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "body",
+				Message: fmt.Sprintf("the body is not a GraphQL request: %v", err),
+			})
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		WriteProblem(w, r, &Err{
+			Code:    http.StatusMethodNotAllowed,
+			Message: fmt.Sprintf("the method %v is not allowed on the path %v", r.Method, r.URL.Path),
+		})
+		return
+	}
+	if request.Query == "" {
+		WriteProblem(w, r, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "query",
+			Message: "the GraphQL query is required",
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	json.NewEncoder(w).Encode(h.Execute(r.Context(), request))
+}
+
+// Execute parses and validates the query, checks its depth and complexity, and executes it.
+func (h *GraphQLHandler) Execute(ctx context.Context, request GraphQLRequest) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if validation := graphql.ValidateDocument(&h.schema, document, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+	if err := CheckQueryLimits(document, request.Variables); err != nil {
+		// Wrapped in a gqlerrors.Error, as the errors of the resolvers are, so its extensions are reported.
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(gqlerrors.NewError(err.Error(), nil, "", nil, nil, err))}
+	}
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	})
+}
+
+// CheckQueryLimits returns an error when an operation of the document is deeper than GraphQLMaxDepth, or
+// GraphQLMaxIntrospectionDepth through an introspection field, or more complex than GraphQLMaxComplexity.
+func CheckQueryLimits(document *ast.Document, variables map[string]any) error {
+	cost := queryCost{fragments: map[string]*ast.FragmentDefinition{}, variables: variables}
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			cost.fragments[fragment.Name.Value] = fragment
+		}
+	}
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		measured := cost.of(operation.SelectionSet)
+		if measured.depth > GraphQLMaxDepth {
+			return graphQLError{&Err{
+				Code:    http.StatusBadRequest,
+				Field:   "query",
+				Message: fmt.Sprintf("the query has a depth of %v, more than the maximum of %v", measured.depth, GraphQLMaxDepth),
+			}}
+		}
+		if measured.introspectionDepth > GraphQLMaxIntrospectionDepth {
+			return graphQLError{&Err{
+				Code:    http.StatusBadRequest,
+				Field:   "query",
+				Message: fmt.Sprintf("the query has an introspection depth of %v, more than the maximum of %v", measured.introspectionDepth, GraphQLMaxIntrospectionDepth),
+			}}
+		}
+		if complexity := measured.complexity; complexity > GraphQLMaxComplexity {
+			return graphQLError{&Err{
+				Code:    http.StatusBadRequest,
+				Field:   "query",
+				Message: fmt.Sprintf("the query has a complexity of %v, more than the maximum of %v", complexity, GraphQLMaxComplexity),
+			}}
+		}
+	}
+	return nil
+}
+
+// queryCost measures the depth and the complexity of the operations of a validated document, which has no fragment
+// cycles.
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+// selectionCost represents the depth and the complexity of a selection set. The depth of the paths through an
+// introspection field is measured apart, as introspectionDepth.
+type selectionCost struct {
+	depth              int
+	introspectionDepth int
+	complexity         int
+}
+
+// of returns the depth and the complexity of a selection set.
+func (c queryCost) of(selectionSet *ast.SelectionSet) selectionCost {
+	var total selectionCost
+	if selectionSet == nil {
+		return total
+	}
+	for _, selection := range selectionSet.Selections {
+		var measured selectionCost
+		switch selection := selection.(type) {
+		case *ast.Field:
+			child := c.of(selection.SelectionSet)
+			cost := 1
+			if selection.Name.Value == "calculate" {
+				cost = graphQLCalculateCost
+			}
+			measured.complexity = cost + c.multiplier(selection)*child.complexity
+			switch {
+			case strings.HasPrefix(selection.Name.Value, "__"):
+				measured.introspectionDepth = max(child.depth, child.introspectionDepth) + 1
+			case child.introspectionDepth > 0:
+				measured.depth = child.depth + 1
+				measured.introspectionDepth = child.introspectionDepth + 1
+			default:
+				measured.depth = child.depth + 1
+			}
+		case *ast.InlineFragment:
+			measured = c.of(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if fragment, ok := c.fragments[selection.Name.Value]; ok {
+				measured = c.of(fragment.SelectionSet)
+			}
+		}
+		total.depth = max(total.depth, measured.depth)
+		total.introspectionDepth = max(total.introspectionDepth, measured.introspectionDepth)
+		total.complexity += measured.complexity
+	}
+	return total
+}
+
+// multiplier returns how many times the fields selected on a field are resolved: once per year for tax_years, once
+// otherwise.
+func (c queryCost) multiplier(field *ast.Field) int {
+	if field.Name.Value != "tax_years" {
+		return 1
+	}
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "years" {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.ListValue:
+			return len(value.Values)
+		case *ast.Variable:
+			if years, ok := c.variables[value.Name.Value].([]any); ok {
+				return len(years)
+			}
+		}
+	}
+	return len(FederalTaxYears.Years)
+}
+
+// graphQLError reports an Err in the errors of a GraphQL response, with its status, problem type and field in the
+// extensions.
+type graphQLError struct {
+	*Err
+}
+
+// Error returns the message of the error.
+func (e graphQLError) Error() string {
+	return e.Message
+}
+
+// Extensions returns the status, the problem type and the field of the error.
+func (e graphQLError) Extensions() map[string]any {
+	extensions := map[string]any{
+		"status": e.Code,
+		"type":   problemTypes[e.Code],
+	}
+	if e.Field != "" {
+		extensions["field"] = e.Field
+	}
+	return extensions
+}
+
+// graphQLJurisdiction represents a jurisdiction in the GraphQL schema.
+type graphQLJurisdiction struct {
+	Code           string            `json:"code"`
+	Currency       string            `json:"currency"`
+	Years          []string          `json:"years"`
+	FilingStatuses []string          `json:"filing_statuses"`
+	Provinces      []graphQLProvince `json:"provinces"`
+}
+
+// graphQLProvince represents a province in the GraphQL schema.
+type graphQLProvince struct {
+	Code         string   `json:"code"`
+	TaxAuthority string   `json:"tax_authority"`
+	Years        []string `json:"years"`
+}
+
+// graphQLJurisdictions returns the supported jurisdictions with their years, filing statuses and provinces.
+func graphQLJurisdictions() []graphQLJurisdiction {
+	canada := graphQLJurisdiction{
+		Code:           JurisdictionCanada,
+		Currency:       JurisdictionCurrencies[JurisdictionCanada],
+		FilingStatuses: []string{},
+	}
+	for _, taxYear := range FederalTaxYears.Years {
+		canada.Years = append(canada.Years, taxYear.Year)
+	}
+	for province, brackets := range ProvincialTaxBrackets {
+		canada.Provinces = append(canada.Provinces, graphQLProvince{
+			Code:         province,
+			TaxAuthority: ProvincialTaxAuthority(province),
+			Years:        sortedKeys(brackets),
+		})
+	}
+	sort.Slice(canada.Provinces, func(i, j int) bool {
+		return canada.Provinces[i].Code < canada.Provinces[j].Code
+	})
+
+	us := graphQLJurisdiction{
+		Code:     JurisdictionUS,
+		Currency: JurisdictionCurrencies[JurisdictionUS],
+		Years:    sortedKeys(USFederalTaxBrackets),
+		FilingStatuses: []string{
+			FilingStatusSingle,
+			FilingStatusMarriedFilingJointly,
+			FilingStatusMarriedFilingSeparately,
+			FilingStatusHeadOfHousehold,
+		},
+		Provinces: []graphQLProvince{},
+	}
+	return []graphQLJurisdiction{canada, us}
+}
+
+// sortedKeys returns the keys of the map, sorted.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// NewGraphQLSchema returns the GraphQL schema of the jurisdictions, the federal tax brackets of every year and the
+// calculations, resolved through the TaxService. The fields have the names of the v2 JSON schema.
+func NewGraphQLSchema(s *TaxService) (graphql.Schema, error) {
+	nonNullString := graphql.NewNonNull(graphql.String)
+	nonNullFloat := graphql.NewNonNull(graphql.Float)
+	nonNullStrings := graphql.NewNonNull(graphql.NewList(nonNullString))
+
+	provinceType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Province",
+		Description: "Province with provincial tax brackets.",
+		Fields: graphql.Fields{
+			"code":          &graphql.Field{Type: nonNullString, Description: "Postal abbreviation, e.g. ON."},
+			"tax_authority": &graphql.Field{Type: nonNullString, Description: "Authority the provincial return is filed with."},
+			"years":         &graphql.Field{Type: nonNullStrings, Description: "Years with provincial tax brackets."},
+		},
+	})
+	jurisdictionType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Jurisdiction",
+		Description: "Tax jurisdiction supported by the calculations.",
+		Fields: graphql.Fields{
+			"code":            &graphql.Field{Type: nonNullString, Description: "Jurisdiction code, CA or US."},
+			"currency":        &graphql.Field{Type: nonNullString, Description: "ISO 4217 currency code of the amounts."},
+			"years":           &graphql.Field{Type: nonNullStrings, Description: "Years with federal tax brackets."},
+			"filing_statuses": &graphql.Field{Type: nonNullStrings, Description: "Filing statuses a calculation requires, if any."},
+			"provinces":       &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(provinceType)))},
+		},
+	})
+	provenanceType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Provenance",
+		Description: "Where the federal tax brackets of a tax year come from.",
+		Fields: graphql.Fields{
+			"year":             &graphql.Field{Type: nonNullString},
+			"source":           &graphql.Field{Type: nonNullString, Description: "Name of the authoritative source document."},
+			"source_url":       &graphql.Field{Type: nonNullString},
+			"publication_date": &graphql.Field{Type: graphql.String, Resolve: resolveDate(func(p *api.TaxYearProvenance) *openapi_types.Date { return p.PublicationDate })},
+			"last_verified":    &graphql.Field{Type: graphql.String, Resolve: resolveDate(func(p *api.TaxYearProvenance) *openapi_types.Date { return p.LastVerified })},
+			"notes":            &graphql.Field{Type: graphql.String},
+			"dataset_version":  &graphql.Field{Type: nonNullString},
+		},
+	})
+	taxBracketType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "TaxBracket",
+		Description: "Federal tax bracket of a tax year.",
+		Fields: graphql.Fields{
+			"min":  &graphql.Field{Type: nonNullFloat},
+			"max":  &graphql.Field{Type: graphql.Float, Description: "Upper limit of the bracket, or null for the open-ended top bracket."},
+			"rate": &graphql.Field{Type: nonNullFloat, Description: "Marginal rate of the bracket as a fraction, e.g. 0.205."},
+		},
+	})
+	taxYearType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "TaxYear",
+		Description: "Federal tax brackets of a tax year.",
+		Fields: graphql.Fields{
+			"year":            &graphql.Field{Type: nonNullString},
+			"jurisdiction":    &graphql.Field{Type: nonNullString},
+			"currency":        &graphql.Field{Type: nonNullString},
+			"dataset_version": &graphql.Field{Type: nonNullString},
+			"brackets":        &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(taxBracketType)))},
+			"provenance":      &graphql.Field{Type: provenanceType},
+		},
+	})
+	bandType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Band",
+		Description: "Tax bracket of a calculation, with the part of the taxable income in it and the tax owed on it.",
+		Fields: graphql.Fields{
+			"min":            &graphql.Field{Type: nonNullFloat},
+			"max":            &graphql.Field{Type: graphql.Float, Description: "Upper limit of the band, or null for the open-ended top band."},
+			"rate":           &graphql.Field{Type: nonNullFloat},
+			"taxable_amount": &graphql.Field{Type: nonNullFloat},
+			"tax_owed":       &graphql.Field{Type: nonNullFloat},
+		},
+	})
+	adjustmentType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Adjustment",
+		Description: "Credit or payroll contribution of a calculation.",
+		Fields: graphql.Fields{
+			"name":   &graphql.Field{Type: nonNullString},
+			"amount": &graphql.Field{Type: nonNullFloat},
+		},
+	})
+	bands := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(bandType)))
+	adjustments := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(adjustmentType)))
+	calculationType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Calculation",
+		Description: "Tax calculation, with the fields of the v2 calculation response.",
+		Fields: graphql.Fields{
+			"tax_year":                 &graphql.Field{Type: nonNullString},
+			"jurisdiction":             &graphql.Field{Type: nonNullString},
+			"currency":                 &graphql.Field{Type: nonNullString},
+			"filing_status":            &graphql.Field{Type: graphql.String},
+			"province":                 &graphql.Field{Type: graphql.String},
+			"provincial_tax_authority": &graphql.Field{Type: graphql.String},
+			"salary":                   &graphql.Field{Type: nonNullFloat},
+			"total_income":             &graphql.Field{Type: nonNullFloat},
+			"deductions":               &graphql.Field{Type: nonNullFloat},
+			"standard_deduction":       &graphql.Field{Type: graphql.Float},
+			"taxable_income":           &graphql.Field{Type: nonNullFloat},
+			"federal_tax_owed":         &graphql.Field{Type: nonNullFloat},
+			"provincial_tax_owed":      &graphql.Field{Type: nonNullFloat},
+			"total_tax_owed":           &graphql.Field{Type: nonNullFloat},
+			"effective_tax_rate":       &graphql.Field{Type: nonNullFloat, Description: "Total tax owed as a fraction of the total income."},
+			"marginal_tax_rate":        &graphql.Field{Type: nonNullFloat, Description: "Federal and provincial rate of the next dollar."},
+			"net_income":               &graphql.Field{Type: nonNullFloat},
+			"tax_withheld":             &graphql.Field{Type: nonNullFloat},
+			"instalments_paid":         &graphql.Field{Type: nonNullFloat},
+			"refund":                   &graphql.Field{Type: nonNullFloat},
+			"balance_owing":            &graphql.Field{Type: nonNullFloat},
+			"bands":                    &graphql.Field{Type: bands},
+			"provincial_bands":         &graphql.Field{Type: bands},
+			"credits":                  &graphql.Field{Type: adjustments},
+			"contributions":            &graphql.Field{Type: adjustments},
+			"dataset_version":          &graphql.Field{Type: nonNullString},
+			"provenance":               &graphql.Field{Type: provenanceType},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"jurisdictions": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(jurisdictionType))),
+				Description: "Jurisdictions supported by the calculations.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return graphQLJurisdictions(), nil
+				},
+			},
+			"tax_years": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(taxYearType))),
+				Description: "Federal tax brackets of the given years, or of every year, sorted by year.",
+				Args: graphql.FieldConfigArgument{
+					"years": &graphql.ArgumentConfig{
+						Type:        graphql.NewList(nonNullString),
+						Description: "Years, or the symbolic years latest, current or previous.",
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					years, ok := p.Args["years"].([]any)
+					if !ok {
+						taxYears := make([]api.TaxYearV2, len(FederalTaxYears.Years))
+						for i, taxYear := range FederalTaxYears.Years {
+							taxYears[i] = mapTaxYearToAPITaxYearV2(taxYear)
+						}
+						return taxYears, nil
+					}
+					taxYears := make([]api.TaxYearV2, len(years))
+					for i, year := range years {
+						taxYear, err := s.taxYear(year.(string))
+						if err != nil {
+							return nil, graphQLError{err}
+						}
+						taxYears[i] = mapTaxYearToAPITaxYearV2(taxYear)
+					}
+					return taxYears, nil
+				},
+			},
+			"tax_year": &graphql.Field{
+				Type:        taxYearType,
+				Description: "Federal tax brackets of the given year.",
+				Args: graphql.FieldConfigArgument{
+					"year": &graphql.ArgumentConfig{
+						Type:        nonNullString,
+						Description: "Year, or one of the symbolic years latest, current or previous.",
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					taxYear, err := s.taxYear(p.Args["year"].(string))
+					if err != nil {
+						return nil, graphQLError{err}
+					}
+					return mapTaxYearToAPITaxYearV2(taxYear), nil
+				},
+			},
+			"calculate": &graphql.Field{
+				Type:        calculationType,
+				Description: "Calculates the tax owed for the year, like POST /v2/tax-years/{year}/calculate.",
+				Args: graphql.FieldConfigArgument{
+					"year":                   &graphql.ArgumentConfig{Type: nonNullString},
+					"salary":                 &graphql.ArgumentConfig{Type: nonNullFloat},
+					"jurisdiction":           &graphql.ArgumentConfig{Type: graphql.String},
+					"filing_status":          &graphql.ArgumentConfig{Type: graphql.String},
+					"province":               &graphql.ArgumentConfig{Type: graphql.String},
+					"deductions":             &graphql.ArgumentConfig{Type: graphql.Float},
+					"tax_withheld":           &graphql.ArgumentConfig{Type: graphql.Float},
+					"instalments_paid":       &graphql.ArgumentConfig{Type: graphql.Float},
+					"non_resident":           &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"canadian_source_income": &graphql.ArgumentConfig{Type: graphql.Float},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					taxOwed, err := s.calculate(p.Args["year"].(string), mapGraphQLArgsToCalculateRequest(p.Args))
+					if err != nil {
+						return nil, graphQLError{err}
+					}
+					return mapTaxOwedToAPICalculateResponseV2(taxOwed), nil
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// resolveDate returns the resolver of a date of the provenance, formatted like 2006-01-02, or null when unknown.
+func resolveDate(date func(*api.TaxYearProvenance) *openapi_types.Date) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		provenance, ok := p.Source.(*api.TaxYearProvenance)
+		if !ok || date(provenance) == nil {
+			return nil, nil
+		}
+		return date(provenance).String(), nil
+	}
+}
+
+// mapGraphQLArgsToCalculateRequest maps the arguments of the calculate field to a calculation request.
+func mapGraphQLArgsToCalculateRequest(args map[string]any) api.CalculateRequest {
+	request := api.CalculateRequest{Salary: args["salary"].(float64)}
+	request.Jurisdiction, _ = args["jurisdiction"].(string)
+	request.FilingStatus, _ = args["filing_status"].(string)
+	request.Province, _ = args["province"].(string)
+	request.NonResident, _ = args["non_resident"].(bool)
+	for name, amount := range map[string]*float64{
+		"deductions":             &request.Deductions,
+		"tax_withheld":           &request.TaxWithheld,
+		"instalments_paid":       &request.InstalmentsPaid,
+		"canadian_source_income": &request.CanadianSourceIncome,
+	} {
+		if value, ok := args[name].(float64); ok {
+			*amount = value
+		}
+	}
+	return request
+}
+
+// graphiQLPage is the GraphiQL page served to browsers in development.
+const graphiQLPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>Tax Calculator GraphiQL</title>
+  <link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css" />
+</head>
+<body style="margin: 0;">
+  <div id="graphiql" style="height: 100vh;"></div>
+  <script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+  <script>
+    const fetcher = GraphiQL.createFetcher({ url: window.location.pathname });
+    ReactDOM.createRoot(document.getElementById("graphiql")).render(React.createElement(GraphiQL, { fetcher }));
+  </script>
+</body>
+</html>
+`
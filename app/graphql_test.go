@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+// TestGraphQL tests that several years and calculations are fetched in one query, with the values of the v2 API.
+func TestGraphQL(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	body := `{
+		"query": "query($years: [String!]) { jurisdictions { code currency } tax_years(years: $years) { year brackets { max rate } } ontario: calculate(year: \"2023\", salary: 100000, province: \"ON\") { total_tax_owed effective_tax_rate marginal_tax_rate provincial_bands { tax_owed } } us: calculate(year: \"2023\", salary: 100000, jurisdiction: \"US\", filing_status: \"single\") { total_tax_owed standard_deduction } }",
+		"variables": {"years": ["2022", "latest"]}
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/tax-calculator/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var response struct {
+		Data struct {
+			Jurisdictions []struct{ Code, Currency string }
+			TaxYears      []struct {
+				Year     string
+				Brackets []struct {
+					Max  *float64
+					Rate float64
+				}
+			} `json:"tax_years"`
+			Ontario struct {
+				TotalTaxOwed     float64 `json:"total_tax_owed"`
+				EffectiveTaxRate float64 `json:"effective_tax_rate"`
+				MarginalTaxRate  float64 `json:"marginal_tax_rate"`
+				ProvincialBands  []struct {
+					TaxOwed float64 `json:"tax_owed"`
+				} `json:"provincial_bands"`
+			}
+			US struct {
+				TotalTaxOwed      float64  `json:"total_tax_owed"`
+				StandardDeduction *float64 `json:"standard_deduction"`
+			}
+		}
+		Errors []any
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if len(response.Errors) > 0 {
+		t.Fatalf("got errors %v, want none", response.Errors)
+	}
+	if len(response.Data.Jurisdictions) != 2 || response.Data.Jurisdictions[1].Currency != "USD" {
+		t.Errorf("got jurisdictions %v, want CA and US", response.Data.Jurisdictions)
+	}
+	if len(response.Data.TaxYears) != 2 || response.Data.TaxYears[0].Year != "2022" || response.Data.TaxYears[1].Year != LatestTaxYear() {
+		t.Fatalf("got tax years %v, want 2022 and %v", response.Data.TaxYears, LatestTaxYear())
+	}
+	if brackets := response.Data.TaxYears[0].Brackets; brackets[len(brackets)-1].Max != nil || brackets[0].Rate != 0.15 {
+		t.Errorf("got brackets %v, want a first rate of 0.15 and an open-ended top bracket", brackets)
+	}
+	if ontario := response.Data.Ontario; ontario.TotalTaxOwed != 22128.14 || ontario.EffectiveTaxRate != 0.2213 || ontario.MarginalTaxRate != 0.3166 || len(ontario.ProvincialBands) != 3 {
+		t.Errorf("got Ontario calculation %+v, want the v2 calculation", ontario)
+	}
+	if us := response.Data.US; us.TotalTaxOwed != 14261 || us.StandardDeduction == nil {
+		t.Errorf("got US calculation %+v, want the v2 calculation", us)
+	}
+}
+
+// TestGraphQLErrors tests that the errors of the resolvers and the queries over the limits are reported in the
+// errors of the response.
+func TestGraphQLErrors(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var calculations []string
+	for i := 0; i < GraphQLMaxComplexity/graphQLCalculateCost; i++ {
+		calculations = append(calculations, "c"+strings.Repeat("x", i)+`: calculate(year: "2022", salary: 1) { total_tax_owed }`)
+	}
+	var tests = []struct {
+		name      string
+		query     string
+		message   string
+		extension any
+	}{
+		{"not found", `{ calculate(year: "1999", salary: 1) { total_tax_owed } }`, "tax brackets for the tax year '1999' is not found", ProblemTypeNotFound},
+		{"invalid", `{ tax_year(year: "abc") { year } }`, "the tax year abc is not a valid year", ProblemTypeInvalidRequest},
+		{"unknown field", `{ tax_brackets { year } }`, `Cannot query field "tax_brackets" on type "Query". Did you mean "tax_years"?`, nil},
+		{"complexity", "{ " + strings.Join(calculations, " ") + " }", "the query has a complexity of 1040, more than the maximum of 1000", ProblemTypeInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(GraphQLRequest{Query: tt.query})
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/tax-calculator/graphql", strings.NewReader(string(body))))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			var response struct {
+				Errors []struct {
+					Message    string
+					Extensions map[string]any
+				}
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if len(response.Errors) != 1 {
+				t.Fatalf("got errors %v, want one", response.Errors)
+			}
+			if response.Errors[0].Message != tt.message {
+				t.Errorf("got message %v, want %v", response.Errors[0].Message, tt.message)
+			}
+			if extension := response.Errors[0].Extensions["type"]; extension != tt.extension {
+				t.Errorf("got type %v, want %v", extension, tt.extension)
+			}
+		})
+	}
+}
+
+// TestCheckQueryLimits tests the depth and the complexity of queries, through fragments and variables.
+func TestCheckQueryLimits(t *testing.T) {
+	var tests = []struct {
+		name      string
+		query     string
+		variables map[string]any
+		wantErr   bool
+	}{
+		{"shallow", `{ tax_year(year: "2022") { year } }`, nil, false},
+		{"deepest", `{ a { b { c { d { e { f { g { h } } } } } } } }`, nil, false},
+		{"too deep", `{ a { b { c { d { e { f { g { h { i } } } } } } } } }`, nil, true},
+		{"too deep through fragments", `{ a { b { c { ...D } } } } fragment D on T { d { e { ... on T { f { g { h { i } } } } } } }`, nil, true},
+		{"introspection", `{ __schema { types { fields { type { ofType { ofType { ofType { ofType { ofType { name } } } } } } } } } }`, nil, false},
+		{"GraphiQL introspection", testutil.IntrospectionQuery, nil, false},
+		{"typename of the deepest field", `{ a { b { c { d { e { f { g { h { __typename } } } } } } } } }`, nil, false},
+		{"introspection too deep", `{ __schema { types { fields { type { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { name } } } } } } } } } } } } } } } } } } }`, nil, true},
+		{"introspection too complex", "{ " + strings.Repeat(`__type(name: "Query") { fields { name } } `, 400) + "}", nil, true},
+		{"every year", `{ tax_years { year brackets { min max rate } provenance { source } } }`, nil, false},
+		{"every year too complex", `{ tax_years { year brackets { min max rate } provenance { source source_url notes dataset_version year publication_date last_verified } b: brackets { min max rate } c: brackets { min max rate } d: brackets { min max rate } e: brackets { min max rate } f: brackets { min max rate } g: brackets { min max rate } h: brackets { min max rate } i: brackets { min max rate } } }`, nil, true},
+		{"years of the variables", `query($years: [String!]) { tax_years(years: $years) { year brackets { min max rate } provenance { source source_url notes dataset_version year publication_date last_verified } b: brackets { min max rate } c: brackets { min max rate } d: brackets { min max rate } e: brackets { min max rate } f: brackets { min max rate } g: brackets { min max rate } h: brackets { min max rate } i: brackets { min max rate } } }`, map[string]any{"years": []any{"2022"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := parser.Parse(parser.ParseParams{Source: tt.query})
+			if err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if err := CheckQueryLimits(document, tt.variables); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestGraphiQL tests that the GraphiQL page is only served in development.
+func TestGraphiQL(t *testing.T) {
+	for _, graphiQL := range []bool{true, false} {
+		handler, err := NewGraphQLHandler(NewTaxService(), graphiQL)
+		if err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		req := httptest.NewRequest(http.MethodGet, "/tax-calculator/graphql", nil)
+		req.Header.Set("Accept", "text/html,application/xhtml+xml")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		page := strings.Contains(rec.Body.String(), "GraphiQL.createFetcher")
+		if page != graphiQL {
+			t.Errorf("got the GraphiQL page %v with GraphiQL enabled %v, want %v", page, graphiQL, graphiQL)
+		}
+		if !graphiQL && rec.Code != http.StatusBadRequest {
+			t.Errorf("got status %v, want %v", rec.Code, http.StatusBadRequest)
+		}
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tax-calculator/graphql?query="+url.QueryEscape(`{ tax_year(year: "2022") { year } }`), nil))
+		if want := `{"data":{"tax_year":{"year":"2022"}}}`; strings.TrimSpace(rec.Body.String()) != want {
+			t.Errorf("got %v, want %v", rec.Body.String(), want)
+		}
+	}
+}
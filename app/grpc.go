@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"patrickyau/interview-test-server/api/taxcalculatorpb"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCAddr is the address the gRPC server listens on, next to the HTTP server.
+const GRPCAddr = ":9090"
+
+// NewGRPCServer returns the gRPC server of the service, with the TaxCalculator service, the standard health service and
+// server reflection.
+func NewGRPCServer(service *TaxService) *grpc.Server {
+	server := grpc.NewServer()
+	taxcalculatorpb.RegisterTaxCalculatorServer(server, &grpcServer{TaxService: service})
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(taxcalculatorpb.TaxCalculator_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	reflection.Register(server)
+	return server
+}
+
+// grpcServer implements the TaxCalculator gRPC service with the TaxService methods of the REST API.
+type grpcServer struct {
+	taxcalculatorpb.UnimplementedTaxCalculatorServer
+	*TaxService
+}
+
+// ListTaxYears returns the federal tax brackets of every year, sorted by year.
+func (s *grpcServer) ListTaxYears(ctx context.Context, request *taxcalculatorpb.ListTaxYearsRequest) (*taxcalculatorpb.ListTaxYearsResponse, error) {
+	response := &taxcalculatorpb.ListTaxYearsResponse{
+		TaxYears:       make([]*taxcalculatorpb.TaxYear, len(FederalTaxYears.Years)),
+		DatasetVersion: DatasetVersion,
+	}
+	for i, taxYear := range FederalTaxYears.Years {
+		response.TaxYears[i] = mapAPITaxYearV2ToProto(mapTaxYearToAPITaxYearV2(taxYear))
+	}
+	return response, nil
+}
+
+// GetTaxYear returns the federal tax brackets of the given year.
+func (s *grpcServer) GetTaxYear(ctx context.Context, request *taxcalculatorpb.GetTaxYearRequest) (*taxcalculatorpb.TaxYear, error) {
+	taxYear, err := s.taxYear(request.GetYear())
+	if err != nil {
+		return nil, grpcStatusError(err)
+	}
+	return mapAPITaxYearV2ToProto(mapTaxYearToAPITaxYearV2(taxYear)), nil
+}
+
+// Calculate calculates the tax owed for the year, like CalculateV2.
+func (s *grpcServer) Calculate(ctx context.Context, request *taxcalculatorpb.CalculateRequest) (*taxcalculatorpb.Calculation, error) {
+	input, err := mapProtoToCalculateRequest(request)
+	if err != nil {
+		return nil, grpcStatusError(err)
+	}
+	taxOwed, err := s.calculate(request.GetYear(), input)
+	if err != nil {
+		return nil, grpcStatusError(err)
+	}
+	return mapAPICalculateResponseV2ToProto(mapTaxOwedToAPICalculateResponseV2(taxOwed)), nil
+}
+
+// grpcStatusError maps an Err to a gRPC status: NOT_FOUND for 404 and INVALID_ARGUMENT otherwise, with the invalid
+// field in a BadRequest detail.
+func grpcStatusError(err *Err) error {
+	code := codes.InvalidArgument
+	if err.Code == http.StatusNotFound {
+		code = codes.NotFound
+	}
+	st := status.New(code, err.Message)
+	if err.Field == "" {
+		return st.Err()
+	}
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: err.Field, Description: err.Message}},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// mapProtoToCalculateRequest maps a gRPC calculation request to the request of the REST API.
+func mapProtoToCalculateRequest(request *taxcalculatorpb.CalculateRequest) (api.CalculateRequest, *Err) {
+	input := api.CalculateRequest{
+		Salary:               request.GetSalary(),
+		Jurisdiction:         request.GetJurisdiction(),
+		FilingStatus:         request.GetFilingStatus(),
+		Province:             request.GetProvince(),
+		Deductions:           request.GetDeductions(),
+		TaxWithheld:          request.GetTaxWithheld(),
+		InstalmentsPaid:      request.GetInstalmentsPaid(),
+		NonResident:          request.GetNonResident(),
+		CanadianSourceIncome: request.GetCanadianSourceIncome(),
+	}
+	for _, item := range request.GetIncomeItems() {
+		input.IncomeItems = append(input.IncomeItems, api.IncomeItemInput{
+			Amount:      item.GetAmount(),
+			Currency:    item.GetCurrency(),
+			Description: item.GetDescription(),
+		})
+	}
+	var err *Err
+	if input.ResidencyStartDate, err = parseProtoDate("residency_start_date", request.GetResidencyStartDate()); err != nil {
+		return api.CalculateRequest{}, err
+	}
+	if input.ResidencyEndDate, err = parseProtoDate("residency_end_date", request.GetResidencyEndDate()); err != nil {
+		return api.CalculateRequest{}, err
+	}
+	return input, nil
+}
+
+// parseProtoDate parses a date of a gRPC request formatted as 2006-01-02, or returns nil if it is empty.
+func parseProtoDate(field string, date string) (*openapi_types.Date, *Err) {
+	if date == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return nil, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   field,
+			Message: fmt.Sprintf("the %v must be a date formatted as YYYY-MM-DD. Invalid value: %v", field, date),
+		}
+	}
+	return &openapi_types.Date{Time: t}, nil
+}
+
+// mapAPITaxYearV2ToProto maps an api.TaxYearV2 to its gRPC message.
+func mapAPITaxYearV2ToProto(taxYear api.TaxYearV2) *taxcalculatorpb.TaxYear {
+	response := &taxcalculatorpb.TaxYear{
+		Year:           taxYear.Year,
+		Jurisdiction:   taxYear.Jurisdiction,
+		Currency:       taxYear.Currency,
+		Brackets:       make([]*taxcalculatorpb.TaxBracket, len(taxYear.Brackets)),
+		Provenance:     mapAPITaxYearProvenanceToProto(taxYear.Provenance),
+		DatasetVersion: taxYear.DatasetVersion,
+	}
+	for i, bracket := range taxYear.Brackets {
+		response.Brackets[i] = &taxcalculatorpb.TaxBracket{
+			Min:  bracket.Min,
+			Max:  bracket.Max,
+			Rate: bracket.Rate,
+		}
+	}
+	return response
+}
+
+// mapAPITaxYearProvenanceToProto maps an api.TaxYearProvenance to its gRPC message, or nil when unknown.
+func mapAPITaxYearProvenanceToProto(provenance *api.TaxYearProvenance) *taxcalculatorpb.Provenance {
+	if provenance == nil {
+		return nil
+	}
+	response := &taxcalculatorpb.Provenance{
+		Year:           provenance.Year,
+		Source:         provenance.Source,
+		SourceUrl:      provenance.SourceUrl,
+		Notes:          provenance.Notes,
+		DatasetVersion: provenance.DatasetVersion,
+	}
+	if provenance.PublicationDate != nil {
+		response.PublicationDate = provenance.PublicationDate.String()
+	}
+	if provenance.LastVerified != nil {
+		response.LastVerified = provenance.LastVerified.String()
+	}
+	return response
+}
+
+// mapAPICalculateResponseV2ToProto maps an api.CalculateResponseV2 to its gRPC message.
+func mapAPICalculateResponseV2ToProto(calculation api.CalculateResponseV2) *taxcalculatorpb.Calculation {
+	response := &taxcalculatorpb.Calculation{
+		TaxYear:                calculation.TaxYear,
+		Jurisdiction:           calculation.Jurisdiction,
+		Currency:               calculation.Currency,
+		FilingStatus:           calculation.FilingStatus,
+		Province:               calculation.Province,
+		ProvincialTaxAuthority: calculation.ProvincialTaxAuthority,
+		Salary:                 calculation.Salary,
+		TotalIncome:            calculation.TotalIncome,
+		Deductions:             calculation.Deductions,
+		StandardDeduction:      calculation.StandardDeduction,
+		TaxableIncome:          calculation.TaxableIncome,
+		FederalTaxOwed:         calculation.FederalTaxOwed,
+		ProvincialTaxOwed:      calculation.ProvincialTaxOwed,
+		TotalTaxOwed:           calculation.TotalTaxOwed,
+		EffectiveTaxRate:       calculation.EffectiveTaxRate,
+		MarginalTaxRate:        calculation.MarginalTaxRate,
+		NetIncome:              calculation.NetIncome,
+		Bands:                  mapAPIBandsV2ToProto(calculation.Bands),
+		ProvincialBands:        mapAPIBandsV2ToProto(calculation.ProvincialBands),
+		Credits:                mapAPIAdjustmentsToProto(calculation.Credits),
+		Contributions:          mapAPIAdjustmentsToProto(calculation.Contributions),
+		TaxWithheld:            calculation.TaxWithheld,
+		InstalmentsPaid:        calculation.InstalmentsPaid,
+		Refund:                 calculation.Refund,
+		BalanceOwing:           calculation.BalanceOwing,
+		Rounding: &taxcalculatorpb.RoundingPolicy{
+			Mode:                  calculation.Rounding.Mode,
+			Method:                calculation.Rounding.Method,
+			Precision:             calculation.Rounding.Precision,
+			EffectiveRateDecimals: int32(calculation.Rounding.EffectiveRateDecimals),
+		},
+		Provenance:     mapAPITaxYearProvenanceToProto(calculation.Provenance),
+		DatasetVersion: calculation.DatasetVersion,
+	}
+	for _, item := range calculation.IncomeItems {
+		response.IncomeItems = append(response.IncomeItems, &taxcalculatorpb.IncomeItem{
+			Description:     item.Description,
+			Amount:          item.Amount,
+			Currency:        item.Currency,
+			ExchangeRate:    item.ExchangeRate,
+			ConvertedAmount: item.ConvertedAmount,
+		})
+	}
+	if calculation.Residency != nil {
+		response.Residency = &taxcalculatorpb.Residency{
+			Status:                calculation.Residency.Status,
+			DaysResident:          int32(calculation.Residency.DaysResident),
+			DaysInYear:            int32(calculation.Residency.DaysInYear),
+			CreditProrationFactor: calculation.Residency.CreditProrationFactor,
+			IncomeProrationFactor: calculation.Residency.IncomeProrationFactor,
+		}
+	}
+	return response
+}
+
+// mapAPIBandsV2ToProto maps a slice of api.BandV2 to their gRPC messages.
+func mapAPIBandsV2ToProto(bands []api.BandV2) []*taxcalculatorpb.Band {
+	response := make([]*taxcalculatorpb.Band, len(bands))
+	for i, band := range bands {
+		response[i] = &taxcalculatorpb.Band{
+			Min:           band.Min,
+			Max:           band.Max,
+			Rate:          band.Rate,
+			TaxableAmount: band.TaxableAmount,
+			TaxOwed:       band.TaxOwed,
+		}
+	}
+	return response
+}
+
+// mapAPIAdjustmentsToProto maps a slice of api.Adjustment to their gRPC messages.
+func mapAPIAdjustmentsToProto(adjustments []api.Adjustment) []*taxcalculatorpb.Adjustment {
+	response := make([]*taxcalculatorpb.Adjustment, len(adjustments))
+	for i, adjustment := range adjustments {
+		response[i] = &taxcalculatorpb.Adjustment{
+			Name:   adjustment.Name,
+			Amount: adjustment.Amount,
+		}
+	}
+	return response
+}
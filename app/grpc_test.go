@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"net"
+	"patrickyau/interview-test-server/api"
+	"patrickyau/interview-test-server/api/taxcalculatorpb"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// newGRPCTestClient serves the gRPC server over an in-memory connection and returns a client connection to it.
+func newGRPCTestClient(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := NewGRPCServer(NewTaxService())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// TestGRPCTaxYears tests the ListTaxYears and GetTaxYear methods of the gRPC service.
+func TestGRPCTaxYears(t *testing.T) {
+	client := taxcalculatorpb.NewTaxCalculatorClient(newGRPCTestClient(t))
+	ctx := context.Background()
+
+	taxYears, err := client.ListTaxYears(ctx, &taxcalculatorpb.ListTaxYearsRequest{})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if len(taxYears.GetTaxYears()) != len(FederalTaxYears.Years) || taxYears.GetDatasetVersion() != DatasetVersion {
+		t.Errorf("got %v tax years of the dataset %v, want %v of %v", len(taxYears.GetTaxYears()), taxYears.GetDatasetVersion(), len(FederalTaxYears.Years), DatasetVersion)
+	}
+
+	taxYear, err := client.GetTaxYear(ctx, &taxcalculatorpb.GetTaxYearRequest{Year: "2022"})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	federal, yearErr := FederalTaxYears.Year("2022")
+	if yearErr != nil {
+		t.Fatalf("got error %v, want nil", yearErr)
+	}
+	if want := mapAPITaxYearV2ToProto(mapTaxYearToAPITaxYearV2(federal)); !proto.Equal(taxYear, want) {
+		t.Errorf("got %v, want %v", taxYear, want)
+	}
+	brackets := taxYear.GetBrackets()
+	if brackets[0].GetRate() != 0.15 || brackets[len(brackets)-1].Max != nil {
+		t.Errorf("got brackets %v, want a first rate of 0.15 and an open-ended top bracket", brackets)
+	}
+	if taxYear.GetProvenance().GetSource() == "" {
+		t.Errorf("got no provenance, want one")
+	}
+
+	latest, err := client.GetTaxYear(ctx, &taxcalculatorpb.GetTaxYearRequest{Year: "latest"})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if latest.GetYear() != LatestTaxYear() {
+		t.Errorf("got year %v, want %v", latest.GetYear(), LatestTaxYear())
+	}
+}
+
+// TestGRPCCalculate tests that the Calculate method of the gRPC service returns the calculation of the v2 API.
+func TestGRPCCalculate(t *testing.T) {
+	client := taxcalculatorpb.NewTaxCalculatorClient(newGRPCTestClient(t))
+	var tests = []struct {
+		name    string
+		request *taxcalculatorpb.CalculateRequest
+		input   api.CalculateRequest
+	}{
+		{"federal", &taxcalculatorpb.CalculateRequest{Year: "2022", Salary: 100000}, api.CalculateRequest{Salary: 100000}},
+		{"province", &taxcalculatorpb.CalculateRequest{Year: "2023", Salary: 100000, Province: "ON", TaxWithheld: 25000}, api.CalculateRequest{Salary: 100000, Province: "ON", TaxWithheld: 25000}},
+		{"US", &taxcalculatorpb.CalculateRequest{Year: "2023", Salary: 100000, Jurisdiction: "US", FilingStatus: "single"}, api.CalculateRequest{Salary: 100000, Jurisdiction: "US", FilingStatus: "single"}},
+		{"part-year resident", &taxcalculatorpb.CalculateRequest{Year: "2022", Salary: 50000, ResidencyStartDate: "2022-07-01"}, api.CalculateRequest{Salary: 50000, ResidencyStartDate: mapDateToAPIDate("2022-07-01")}},
+	}
+	s := NewTaxService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculation, err := client.Calculate(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			taxOwed, taxErr := s.calculate(tt.request.GetYear(), tt.input)
+			if taxErr != nil {
+				t.Fatalf("got error %v, want nil", taxErr)
+			}
+			if want := mapAPICalculateResponseV2ToProto(mapTaxOwedToAPICalculateResponseV2(taxOwed)); !proto.Equal(calculation, want) {
+				t.Errorf("got %v, want %v", calculation, want)
+			}
+		})
+	}
+}
+
+// TestGRPCErrors tests that the errors of the gRPC service have the status code and the invalid field.
+func TestGRPCErrors(t *testing.T) {
+	client := taxcalculatorpb.NewTaxCalculatorClient(newGRPCTestClient(t))
+	var tests = []struct {
+		name    string
+		request *taxcalculatorpb.CalculateRequest
+		code    codes.Code
+		field   string
+	}{
+		{"not found", &taxcalculatorpb.CalculateRequest{Year: "1999", Salary: 100000}, codes.NotFound, "year"},
+		{"invalid salary", &taxcalculatorpb.CalculateRequest{Year: "2022", Salary: -1}, codes.InvalidArgument, "salary"},
+		{"invalid province", &taxcalculatorpb.CalculateRequest{Year: "2022", Salary: 100000, Province: "XX"}, codes.InvalidArgument, "province"},
+		{"invalid date", &taxcalculatorpb.CalculateRequest{Year: "2022", Salary: 100000, ResidencyEndDate: "2022-13-01"}, codes.InvalidArgument, "residency_end_date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Calculate(context.Background(), tt.request)
+			st := status.Convert(err)
+			if st.Code() != tt.code {
+				t.Fatalf("got code %v, want %v: %v", st.Code(), tt.code, err)
+			}
+			var field string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					field = badRequest.GetFieldViolations()[0].GetField()
+				}
+			}
+			if field != tt.field {
+				t.Errorf("got field %v, want %v", field, tt.field)
+			}
+		})
+	}
+}
+
+// TestGRPCHealthAndReflection tests that the gRPC server serves the standard health service and server reflection.
+func TestGRPCHealthAndReflection(t *testing.T) {
+	conn := newGRPCTestClient(t)
+	ctx := context.Background()
+
+	for _, service := range []string{"", taxcalculatorpb.TaxCalculator_ServiceDesc.ServiceName} {
+		health, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		if health.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("got status %v for the service %q, want SERVING", health.GetStatus(), service)
+		}
+	}
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	services := map[string]bool{}
+	for _, service := range response.GetListServicesResponse().GetService() {
+		services[service.GetName()] = true
+	}
+	for _, service := range []string{taxcalculatorpb.TaxCalculator_ServiceDesc.ServiceName, healthpb.Health_ServiceDesc.ServiceName} {
+		if !services[service] {
+			t.Errorf("got services %v, want %v", services, service)
+		}
+	}
+}
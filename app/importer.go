@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// archiveCSVHeader is the header of a CSV archive.
+const archiveCSVHeader = "year,min,max,rate,source,source_url,publication_date,last_verified,notes"
+
+// defaultDatasetPath is the tax bracket dataset embedded in the server, relative to the repository root.
+const defaultDatasetPath = "app/data/tax_brackets.json"
+
+// runImport runs the import command, which reads the federal tax brackets from CSV or JSON archives, validates
+// them, merges them into the tax bracket dataset and sets its last modification:
+//
+//	go run ./app import -dataset app/data/tax_brackets.json app/data/archive/ca_federal_tax_brackets.csv
+func runImport(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	datasetPath := flags.String("dataset", defaultDatasetPath, "tax bracket dataset to merge the archives into")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("usage: import [-dataset path] archive.csv|archive.json...")
+	}
+
+	dataset := TaxBracketDataset{}
+	if data, err := os.ReadFile(*datasetPath); err == nil {
+		if dataset, err = LoadTaxBracketDataset(data); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, archive := range flags.Args() {
+		taxYears, err := readTaxBracketArchive(archive)
+		if err != nil {
+			return fmt.Errorf("%v: %w", archive, err)
+		}
+		for _, taxYear := range taxYears {
+			if err := ValidateTaxYear(taxYear); err != nil {
+				return fmt.Errorf("%v: %w", archive, err)
+			}
+		}
+		dataset = dataset.Merge(taxYears)
+		fmt.Fprintf(stdout, "imported %d tax years from %v\n", len(taxYears), archive)
+	}
+
+	dataset.LastModified = time.Now().UTC().Format(time.RFC3339)
+	data, err := json.MarshalIndent(dataset, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*datasetPath, append(data, '\n'), 0o644)
+}
+
+// readTaxBracketArchive reads the tax years of a CSV or JSON archive, depending on its extension.
+func readTaxBracketArchive(path string) ([]TaxYear, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ReadTaxBracketArchiveCSV(file)
+	case ".json":
+		var dataset TaxBracketDataset
+		if err := json.NewDecoder(file).Decode(&dataset); err != nil {
+			return nil, err
+		}
+		return dataset.Years, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive format '%v', want .csv or .json", filepath.Ext(path))
+	}
+}
+
+// ReadTaxBracketArchiveCSV reads the tax years of a CSV archive with one bracket per row, in order, under a
+// year,min,max,rate,source,source_url,publication_date,last_verified,notes header. An empty max is an open-ended
+// bracket; the provenance of a year is read from its first row.
+func ReadTaxBracketArchiveCSV(r io.Reader) ([]TaxYear, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || strings.Join(records[0], ",") != archiveCSVHeader {
+		return nil, fmt.Errorf("the header must be %v", archiveCSVHeader)
+	}
+	var taxYears []TaxYear
+	years := map[string]bool{}
+	for i, record := range records[1:] {
+		values := make([]float64, 3)
+		for j, value := range record[1:4] {
+			if value == "" {
+				continue
+			}
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: '%v' is not a number", i+2, value)
+			}
+			values[j] = number
+		}
+		year := record[0]
+		if len(taxYears) == 0 || taxYears[len(taxYears)-1].Year != year {
+			if years[year] {
+				return nil, fmt.Errorf("line %d: the rows of the tax year '%v' are not contiguous", i+2, year)
+			}
+			years[year] = true
+			taxYears = append(taxYears, TaxYear{
+				Year: year,
+				Provenance: Provenance{
+					Source:          record[4],
+					SourceURL:       record[5],
+					PublicationDate: record[6],
+					LastVerified:    record[7],
+					Notes:           record[8],
+				},
+			})
+		}
+		taxYear := &taxYears[len(taxYears)-1]
+		taxYear.Brackets = append(taxYear.Brackets, TaxBracket{Min: values[0], Max: values[1], Rate: values[2]})
+	}
+	return taxYears, nil
+}
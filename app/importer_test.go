@@ -0,0 +1,147 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestReadTaxBracketArchiveCSV tests the ReadTaxBracketArchiveCSV function.
+func TestReadTaxBracketArchiveCSV(t *testing.T) {
+	archive := archiveCSVHeader + "\n" +
+		"2000,0,30004,0.17,CRA,https://www.canada.ca,,2026-10-19,\n" +
+		"2000,30004,60009,0.25,,,,,\n" +
+		"2000,60009,,0.29,,,,,\n" +
+		"2001,0,,0.16,CRA,https://www.canada.ca,2001-01-01,,Single bracket\n"
+	ans, err := ReadTaxBracketArchiveCSV(strings.NewReader(archive))
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	want := []TaxYear{
+		{
+			Year:       "2000",
+			Brackets:   []TaxBracket{{Min: 0, Max: 30004, Rate: 0.17}, {Min: 30004, Max: 60009, Rate: 0.25}, {Min: 60009, Rate: 0.29}},
+			Provenance: Provenance{Source: "CRA", SourceURL: "https://www.canada.ca", LastVerified: "2026-10-19"},
+		},
+		{
+			Year:       "2001",
+			Brackets:   []TaxBracket{{Min: 0, Rate: 0.16}},
+			Provenance: Provenance{Source: "CRA", SourceURL: "https://www.canada.ca", PublicationDate: "2001-01-01", Notes: "Single bracket"},
+		},
+	}
+	if !reflect.DeepEqual(ans, want) {
+		t.Errorf("got %v, want %v", ans, want)
+	}
+
+	var errors = []struct {
+		name    string
+		archive string
+	}{
+		{"missing header", "2000,0,,0.17,CRA,https://www.canada.ca,,,\n"},
+		{"invalid number", archiveCSVHeader + "\n2000,0,,17%,CRA,https://www.canada.ca,,,\n"},
+		{"rows not contiguous", archiveCSVHeader + "\n2000,0,100,0.17,CRA,https://www.canada.ca,,,\n2001,0,,0.16,CRA,https://www.canada.ca,,,\n2000,100,,0.25,,,,,\n"},
+	}
+	for _, tt := range errors {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadTaxBracketArchiveCSV(strings.NewReader(tt.archive)); err == nil {
+				t.Errorf("got no error, want error")
+			}
+		})
+	}
+}
+
+// TestValidateTaxYear tests the ValidateTaxYear function.
+func TestValidateTaxYear(t *testing.T) {
+	valid := func() TaxYear {
+		return TaxYear{
+			Year:       "2000",
+			Brackets:   []TaxBracket{{Min: 0, Max: 30004, Rate: 0.17}, {Min: 30004, Rate: 0.25}},
+			Provenance: Provenance{Source: "CRA", SourceURL: "https://www.canada.ca", LastVerified: "2026-10-19"},
+		}
+	}
+	var tests = []struct {
+		name   string
+		modify func(*TaxYear)
+		error  bool
+	}{
+		{"valid", func(*TaxYear) {}, false},
+		{"invalid year", func(y *TaxYear) { y.Year = "20x0" }, true},
+		{"no brackets", func(y *TaxYear) { y.Brackets = nil }, true},
+		{"gap between brackets", func(y *TaxYear) { y.Brackets[1].Min = 30005 }, true},
+		{"rate as a percentage", func(y *TaxYear) { y.Brackets[0].Rate = 17 }, true},
+		{"last bracket not open-ended", func(y *TaxYear) { y.Brackets[1].Max = 60009 }, true},
+		{"empty bracket", func(y *TaxYear) { y.Brackets[0].Max = 0; y.Brackets[1].Min = 0 }, true},
+		{"no source", func(y *TaxYear) { y.Provenance.Source = "" }, true},
+		{"relative source URL", func(y *TaxYear) { y.Provenance.SourceURL = "canada.ca" }, true},
+		{"invalid last verified date", func(y *TaxYear) { y.Provenance.LastVerified = "19/10/2026" }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxYear := valid()
+			tt.modify(&taxYear)
+			if err := ValidateTaxYear(taxYear); (err != nil) != tt.error {
+				t.Errorf("got error %v, want error %v", err, tt.error)
+			}
+		})
+	}
+}
+
+// TestRunImport tests that the import command merges the archive into the dataset.
+func TestRunImport(t *testing.T) {
+	dir := t.TempDir()
+	dataset := filepath.Join(dir, "tax_brackets.json")
+	archive := filepath.Join(dir, "archive.csv")
+	if err := os.WriteFile(archive, []byte(archiveCSVHeader+"\n2000,0,,0.17,CRA,https://www.canada.ca,,,\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runImport([]string{"-dataset", dataset, archive}, io.Discard); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if err := os.WriteFile(archive, []byte(archiveCSVHeader+"\n2001,0,,0.16,CRA,https://www.canada.ca,,,\n2000,0,,0.18,CRA,https://www.canada.ca,,,\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runImport([]string{"-dataset", dataset, archive}, io.Discard); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	data, err := os.ReadFile(dataset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ans, err := LoadTaxBracketDataset(data)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if len(ans.Years) != 2 || ans.Years[0].Year != "2000" || ans.Years[0].Brackets[0].Rate != 0.18 || ans.Years[1].Year != "2001" {
+		t.Errorf("got %v, want 2000 replaced and 2001 added", ans.Years)
+	}
+	if ans.LastModified == "" {
+		t.Errorf("got no last modification, want the time of the import")
+	}
+
+	if err := os.WriteFile(archive, []byte(archiveCSVHeader+"\n2002,0,,0.16,,,,,\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runImport([]string{"-dataset", dataset, archive}, io.Discard); err == nil {
+		t.Errorf("got no error for a tax year without a source, want error")
+	}
+}
+
+// TestEmbeddedTaxBracketDataset tests that the embedded dataset covers 2000 onwards and matches its archive.
+func TestEmbeddedTaxBracketDataset(t *testing.T) {
+	taxYears, err := readTaxBracketArchive("data/archive/ca_federal_tax_brackets.csv")
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if !reflect.DeepEqual(FederalTaxYears.Years, taxYears) {
+		t.Errorf("the embedded dataset does not match the archive, run the import command")
+	}
+	for year := 2000; year <= 2023; year++ {
+		if _, ok := TaxBrackets[strconv.Itoa(year)]; !ok {
+			t.Errorf("got no tax brackets for %d, want tax brackets", year)
+		}
+	}
+}
@@ -0,0 +1,637 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"patrickyau/interview-test-server/api"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/rs/zerolog/log"
+)
+
+// Limits of the calculation jobs.
+const (
+	// CalculationJobWorkers is the number of jobs executed at the same time.
+	CalculationJobWorkers = 4
+	// CalculationJobQueueSize is the number of jobs that can wait for a worker. More jobs are refused until one starts.
+	CalculationJobQueueSize = 16
+	// CalculationJobRetention is how long a finished job and its results are kept.
+	CalculationJobRetention = 24 * time.Hour
+	// CalculationJobMaxCalculations is the maximum number of calculations of a job submitted as JSON, whose body is
+	// read in memory. It is the maxItems of the calculations in the OpenAPI spec.
+	CalculationJobMaxCalculations = 10000
+	// CalculationJobMaxStreamedCalculations is the maximum number of calculations of a job streamed as NDJSON.
+	CalculationJobMaxStreamedCalculations = 1000000
+	// CalculationJobMaxStreamSize is the maximum size of the NDJSON body of a streamed job.
+	CalculationJobMaxStreamSize = 256 << 20
+	// calculationJobMaxLineSize is the maximum size of a line of the NDJSON body of a streamed job.
+	calculationJobMaxLineSize = 64 << 10
+)
+
+// ContentTypeNDJSON is the content type of the streamed jobs and of the results of the jobs, a JSON value per line.
+const ContentTypeNDJSON = "application/x-ndjson"
+
+// calculationJobInputSchema is the schema the lines of a streamed job are validated against, as the request
+// validation only validates the JSON bodies.
+var calculationJobInputSchema = mustCalculationJobInputSchema()
+
+// CalculationJobResult is a line of the results of a calculation job: the ID of a calculation and either the
+// calculation, in the v2 schema, or the problem of its input.
+type CalculationJobResult struct {
+	ID          string                   `json:"id"`
+	Calculation *api.CalculateResponseV2 `json:"calculation,omitempty"`
+	Error       *api.ErrorResponses      `json:"error,omitempty"`
+}
+
+// CalculationJobs executes the calculation jobs with a bounded pool of workers, and keeps them until they expire. The
+// jobs are kept in memory, so they do not survive a restart of the server, and their inputs and results in temporary
+// files.
+type CalculationJobs struct {
+	service      *TaxService
+	queue        chan *calculationJob
+	startWorkers sync.Once
+
+	// mu guards the jobs and their state.
+	mu   sync.Mutex
+	jobs map[string]*calculationJob
+}
+
+// calculationJob represents a job, with the file of its inputs until it is executed and the file of its results once
+// it has succeeded. Both files have a JSON value per line.
+type calculationJob struct {
+	job     api.CalculationJob
+	inputs  string
+	results string
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// NewCalculationJobs returns the calculation jobs of the service. The workers are started with the first job.
+func NewCalculationJobs(service *TaxService) *CalculationJobs {
+	return &CalculationJobs{
+		service: service,
+		queue:   make(chan *calculationJob, CalculationJobQueueSize),
+		jobs:    map[string]*calculationJob{},
+	}
+}
+
+// Submit queues a job calculating the inputs for the year. It returns a 413 error when there are more than
+// CalculationJobMaxCalculations inputs, or a 503 error when the queue is full.
+func (j *CalculationJobs) Submit(year string, inputs []api.CalculationJobInput) (api.CalculationJob, *Err) {
+	if len(inputs) > CalculationJobMaxCalculations {
+		return api.CalculationJob{}, &Err{
+			Code:    http.StatusRequestEntityTooLarge,
+			Field:   "calculations",
+			Message: fmt.Sprintf("the job has %v calculations, more than the maximum of %v, stream them as NDJSON instead", len(inputs), CalculationJobMaxCalculations),
+		}
+	}
+	return j.submit(year, func(w io.Writer) (int, *Err) {
+		encoder := json.NewEncoder(w)
+		for _, input := range inputs {
+			if err := encoder.Encode(input); err != nil {
+				return 0, &Err{Code: http.StatusInternalServerError, Message: fmt.Sprintf("the calculations could not be written: %v", err)}
+			}
+		}
+		return len(inputs), nil
+	})
+}
+
+// SubmitStream queues a job calculating the inputs of an NDJSON stream, one per line, for the year. The stream is
+// written to a temporary file rather than read in memory. It returns a 400 error when a line is not a valid
+// calculation, a 413 error when the stream has more than CalculationJobMaxStreamedCalculations or is larger than
+// CalculationJobMaxStreamSize, or a 503 error when the queue is full.
+func (j *CalculationJobs) SubmitStream(year string, body io.Reader) (api.CalculationJob, *Err) {
+	return j.submit(year, func(w io.Writer) (int, *Err) {
+		return copyCalculationJobStream(w, body)
+	})
+}
+
+// submit writes the inputs of a job to a temporary file with write, which returns their number, and queues the job,
+// or returns a 503 error when the queue is full.
+func (j *CalculationJobs) submit(year string, write func(w io.Writer) (int, *Err)) (api.CalculationJob, *Err) {
+	if len(j.queue) == cap(j.queue) {
+		// The inputs are not written when the job would be refused anyway.
+		return api.CalculationJob{}, calculationJobQueueFull()
+	}
+	inputs, total, err := writeCalculationJobInputs(write)
+	if err != nil {
+		return api.CalculationJob{}, err
+	}
+	j.startWorkers.Do(func() {
+		for i := 0; i < CalculationJobWorkers; i++ {
+			go j.work()
+		}
+	})
+
+	now := j.service.Clock()
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &calculationJob{
+		job: api.CalculationJob{
+			Id:        newCalculationJobID(),
+			Status:    api.Queued,
+			Year:      year,
+			Total:     total,
+			CreatedAt: now,
+		},
+		inputs: inputs,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.expire(now)
+	select {
+	case j.queue <- job:
+	default:
+		cancel()
+		job.removeInputs()
+		return api.CalculationJob{}, calculationJobQueueFull()
+	}
+	j.jobs[job.job.Id] = job
+	return job.snapshot(), nil
+}
+
+// calculationJobQueueFull returns the error of a job refused because the queue is full.
+func calculationJobQueueFull() *Err {
+	return &Err{
+		Code:    http.StatusServiceUnavailable,
+		Message: fmt.Sprintf("the queue of jobs is full with %v jobs, submit the job again later", CalculationJobQueueSize),
+	}
+}
+
+// writeCalculationJobInputs writes the inputs of a job to a temporary file with write, and returns its path and the
+// number of inputs. The file is removed if the inputs are invalid or cannot be written.
+func writeCalculationJobInputs(write func(w io.Writer) (int, *Err)) (string, int, *Err) {
+	file, err := os.CreateTemp("", "calculation-job-inputs-*.ndjson")
+	if err != nil {
+		return "", 0, &Err{Code: http.StatusInternalServerError, Message: fmt.Sprintf("the calculations could not be written: %v", err)}
+	}
+	writer := bufio.NewWriter(file)
+	total, inputsErr := write(writer)
+	if inputsErr == nil {
+		err = writer.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if inputsErr == nil && err != nil {
+		inputsErr = &Err{Code: http.StatusInternalServerError, Message: fmt.Sprintf("the calculations could not be written: %v", err)}
+	}
+	if inputsErr != nil {
+		os.Remove(file.Name())
+		return "", 0, inputsErr
+	}
+	return file.Name(), total, nil
+}
+
+// copyCalculationJobStream validates the calculations of an NDJSON stream, one per line, and copies them to w. It
+// returns the number of calculations. The blank lines are skipped.
+func copyCalculationJobStream(w io.Writer, body io.Reader) (int, *Err) {
+	limited := &io.LimitedReader{R: body, N: CalculationJobMaxStreamSize + 1}
+	scanner := bufio.NewScanner(limited)
+	scanner.Buffer(make([]byte, 0, 4096), calculationJobMaxLineSize)
+	total := 0
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if total == CalculationJobMaxStreamedCalculations {
+			return 0, &Err{
+				Code:    http.StatusRequestEntityTooLarge,
+				Field:   "calculations",
+				Message: fmt.Sprintf("the job has more than the maximum of %v calculations", CalculationJobMaxStreamedCalculations),
+			}
+		}
+		if err := validateCalculationJobLine(total, line); err != nil {
+			return 0, err
+		}
+		w.Write(line)
+		w.Write([]byte("\n"))
+		total++
+	}
+	switch err := scanner.Err(); {
+	case limited.N == 0:
+		return 0, &Err{
+			Code:    http.StatusRequestEntityTooLarge,
+			Message: fmt.Sprintf("the request body is larger than the maximum of %v bytes", CalculationJobMaxStreamSize),
+		}
+	case errors.Is(err, bufio.ErrTooLong):
+		return 0, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   fieldPath([]string{"calculations", strconv.Itoa(total)}),
+			Message: fmt.Sprintf("the line %v is longer than the maximum of %v bytes", total+1, calculationJobMaxLineSize),
+		}
+	case err != nil:
+		return 0, &Err{Code: http.StatusBadRequest, Message: fmt.Sprintf("the request body could not be read: %v", err)}
+	case total == 0:
+		return 0, &Err{Code: http.StatusBadRequest, Field: "calculations", Message: "the job has no calculations"}
+	}
+	return total, nil
+}
+
+// validateCalculationJobLine validates the line of the calculation with the index against the schema of the
+// calculations.
+func validateCalculationJobLine(index int, line []byte) *Err {
+	pointer := []string{"calculations", strconv.Itoa(index)}
+	var input any
+	if err := json.Unmarshal(line, &input); err != nil {
+		return &Err{
+			Code:    http.StatusBadRequest,
+			Field:   fieldPath(pointer),
+			Message: fmt.Sprintf("the line %v is not valid JSON: %v", index+1, err),
+		}
+	}
+	if err := calculationJobInputSchema.VisitJSON(input); err != nil {
+		// The error of the allOf of the schema wraps the error of the field.
+		var schemaErr *openapi3.SchemaError
+		for cause := error(err); errors.As(cause, &schemaErr); cause = schemaErr.Unwrap() {
+			if fieldPointer := schemaErr.JSONPointer(); len(fieldPointer) > 0 {
+				pointer = append(pointer, fieldPointer...)
+				break
+			}
+		}
+		return &Err{
+			Code:    http.StatusBadRequest,
+			Field:   fieldPath(pointer),
+			Message: fmt.Sprintf("the line %v is not a valid calculation: %v", index+1, firstLine(err.Error())),
+		}
+	}
+	return nil
+}
+
+// Get returns the job with the ID.
+func (j *CalculationJobs) Get(id string) (api.CalculationJob, *Err) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job, err := j.job(id)
+	if err != nil {
+		return api.CalculationJob{}, err
+	}
+	return job.snapshot(), nil
+}
+
+// Cancel cancels the job with the ID if it is queued or running, and keeps it as cancelled until it expires, or
+// deletes it and its results if it is finished.
+func (j *CalculationJobs) Cancel(id string) (api.CalculationJob, *Err) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job, err := j.job(id)
+	if err != nil {
+		return api.CalculationJob{}, err
+	}
+	switch job.job.Status {
+	case api.Queued, api.Running:
+		job.cancel()
+		job.removeInputs()
+		job.finish(api.Cancelled, j.service.Clock())
+	default:
+		delete(j.jobs, id)
+		job.removeResults()
+	}
+	return job.snapshot(), nil
+}
+
+// Results opens the results of the job with the ID, or returns a 409 error if the job has not succeeded.
+func (j *CalculationJobs) Results(id string) (*os.File, int64, *Err) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job, err := j.job(id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if job.job.Status != api.Succeeded {
+		return nil, 0, &Err{
+			Code:    http.StatusConflict,
+			Field:   "id",
+			Message: fmt.Sprintf("the job '%v' is %v, its results can be downloaded once it has succeeded", id, job.job.Status),
+		}
+	}
+	file, openErr := os.Open(job.results)
+	if openErr == nil {
+		info, statErr := file.Stat()
+		if statErr == nil {
+			return file, info.Size(), nil
+		}
+		file.Close()
+		openErr = statErr
+	}
+	log.Error().Err(openErr).Str("job", id).Msg("error opening the results of the job")
+	return nil, 0, &Err{
+		Code:    http.StatusInternalServerError,
+		Message: fmt.Sprintf("the results of the job '%v' could not be read", id),
+	}
+}
+
+// job expires the finished jobs and returns the job with the ID, or a 404 error. It must be called with mu held.
+func (j *CalculationJobs) job(id string) (*calculationJob, *Err) {
+	j.expire(j.service.Clock())
+	job, ok := j.jobs[id]
+	if !ok {
+		return nil, &Err{
+			Code:    http.StatusNotFound,
+			Field:   "id",
+			Message: fmt.Sprintf("the job '%v' is not found", id),
+		}
+	}
+	return job, nil
+}
+
+// expire deletes the jobs, and their results, that finished more than CalculationJobRetention ago. It must be called
+// with mu held.
+func (j *CalculationJobs) expire(now time.Time) {
+	for id, job := range j.jobs {
+		if job.job.ExpiresAt != nil && now.After(*job.job.ExpiresAt) {
+			delete(j.jobs, id)
+			job.removeResults()
+		}
+	}
+}
+
+// work executes the queued jobs, one at a time.
+func (j *CalculationJobs) work() {
+	for job := range j.queue {
+		j.run(job)
+	}
+}
+
+// run executes a job, unless it was cancelled while it was queued.
+func (j *CalculationJobs) run(job *calculationJob) {
+	j.mu.Lock()
+	if job.job.Status != api.Queued {
+		j.mu.Unlock()
+		return
+	}
+	started := j.service.Clock()
+	job.job.Status = api.Running
+	job.job.StartedAt = &started
+	year, inputs := job.job.Year, job.inputs
+	j.mu.Unlock()
+
+	results, err := j.calculate(job, year, inputs)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job.removeInputs()
+	if job.job.Status == api.Cancelled {
+		// The results of a job cancelled while it was running are discarded.
+		if results != "" {
+			os.Remove(results)
+		}
+		return
+	}
+	job.cancel()
+	if err != nil {
+		log.Error().Err(err).Str("job", job.job.Id).Msg("error writing the results of the job")
+		job.job.Error = fmt.Sprintf("the results could not be written: %v", err)
+		job.finish(api.Failed, j.service.Clock())
+		return
+	}
+	job.results = results
+	job.job.ResultsUrl = calculationJobURL(job.job.Id) + "/results"
+	job.finish(api.Succeeded, j.service.Clock())
+}
+
+// calculate calculates the inputs of a job, read from their file, and writes their results to a temporary file, whose
+// path it returns. It stops when the job is cancelled.
+func (j *CalculationJobs) calculate(job *calculationJob, year string, inputs string) (string, error) {
+	inputsFile, err := os.Open(inputs)
+	if err != nil {
+		return "", err
+	}
+	defer inputsFile.Close()
+	file, err := os.CreateTemp("", "calculation-job-*.ndjson")
+	if err != nil {
+		return "", err
+	}
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	decoder := json.NewDecoder(bufio.NewReader(inputsFile))
+	for {
+		if err = job.ctx.Err(); err != nil {
+			break
+		}
+		var input api.CalculationJobInput
+		if err = decoder.Decode(&input); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			break
+		}
+		result := CalculationJobResult{ID: input.Id}
+		taxOwed, taxErr := j.service.calculate(year, mapCalculationJobInputToCalculateRequest(input))
+		if taxErr != nil {
+			problem := NewProblem(job.ctx, taxErr)
+			result.Error = &problem
+		} else {
+			calculation := mapTaxOwedToAPICalculateResponseV2(taxOwed)
+			result.Calculation = &calculation
+		}
+		if err = encoder.Encode(result); err != nil {
+			break
+		}
+
+		j.mu.Lock()
+		job.job.Processed++
+		if taxErr != nil {
+			job.job.Failed++
+		}
+		j.mu.Unlock()
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// finish sets the final status of the job, and when it expires.
+func (job *calculationJob) finish(status api.CalculationJobStatus, now time.Time) {
+	expires := now.Add(CalculationJobRetention)
+	job.job.Status = status
+	job.job.FinishedAt = &now
+	job.job.ExpiresAt = &expires
+}
+
+// removeInputs removes the file of the inputs of the job, if any.
+func (job *calculationJob) removeInputs() {
+	if job.inputs != "" {
+		os.Remove(job.inputs)
+		job.inputs = ""
+	}
+}
+
+// removeResults removes the file of the results of the job, if any.
+func (job *calculationJob) removeResults() {
+	if job.results != "" {
+		os.Remove(job.results)
+		job.results = ""
+	}
+}
+
+// snapshot returns a copy of the job, with its progress.
+func (job *calculationJob) snapshot() api.CalculationJob {
+	snapshot := job.job
+	if snapshot.Total > 0 {
+		snapshot.Progress = float64(snapshot.Processed) / float64(snapshot.Total)
+	}
+	return snapshot
+}
+
+// newCalculationJobID returns a random ID for a job.
+func newCalculationJobID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// mustCalculationJobInputSchema returns the schema of the calculations of a job in the OpenAPI spec.
+func mustCalculationJobInputSchema() *openapi3.Schema {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		panic(err)
+	}
+	return swagger.Components.Schemas["CalculationJobInput"].Value
+}
+
+// streamedCalculationJob reports whether the body of the request is an NDJSON stream of calculations, which is
+// neither read in memory by the request validation nor limited to MaxRequestBodySize.
+func streamedCalculationJob(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == ContentTypeNDJSON
+}
+
+// calculationJobURL returns the URL of the job with the ID.
+func calculationJobURL(id string) string {
+	return "/tax-calculator/jobs/" + id
+}
+
+// mapCalculationJobInputToCalculateRequest maps a calculation of a job to a calculation request.
+func mapCalculationJobInputToCalculateRequest(input api.CalculationJobInput) api.CalculateRequest {
+	return api.CalculateRequest{
+		Salary:               input.Salary,
+		Jurisdiction:         input.Jurisdiction,
+		FilingStatus:         input.FilingStatus,
+		Province:             input.Province,
+		Deductions:           input.Deductions,
+		TaxWithheld:          input.TaxWithheld,
+		InstalmentsPaid:      input.InstalmentsPaid,
+		ResidencyStartDate:   input.ResidencyStartDate,
+		ResidencyEndDate:     input.ResidencyEndDate,
+		NonResident:          input.NonResident,
+		CanadianSourceIncome: input.CanadianSourceIncome,
+		IncomeItems:          input.IncomeItems,
+	}
+}
+
+// CreateCalculationJob queues a job calculating the tax of a batch of calculations for the year.
+func (s *TaxService) CreateCalculationJob(ctx context.Context, request api.CreateCalculationJobRequestObject) (api.CreateCalculationJobResponseObject, error) {
+	taxYear, err := s.taxYear(request.Body.Year)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.CreateCalculationJob404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.CreateCalculationJob400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	job, err := s.Jobs.Submit(taxYear.Year, request.Body.Calculations)
+	if err != nil {
+		switch err.Code {
+		case http.StatusRequestEntityTooLarge:
+			return api.CreateCalculationJob413ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		case http.StatusServiceUnavailable:
+			return api.CreateCalculationJob503ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return nil, errors.New(err.Message)
+	}
+	return api.CreateCalculationJob202JSONResponse{
+		Body: job,
+		Headers: api.CreateCalculationJob202ResponseHeaders{
+			Location: calculationJobURL(job.Id),
+		},
+	}, nil
+}
+
+// CreateCalculationJobStream queues a job calculating the tax of an NDJSON stream of calculations for the year.
+func (s *TaxService) CreateCalculationJobStream(ctx context.Context, request api.CreateCalculationJobStreamRequestObject) (api.CreateCalculationJobStreamResponseObject, error) {
+	taxYear, err := s.taxYear(request.Year)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.CreateCalculationJobStream404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.CreateCalculationJobStream400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	job, err := s.Jobs.SubmitStream(taxYear.Year, request.Body)
+	if err != nil {
+		switch err.Code {
+		case http.StatusBadRequest:
+			return api.CreateCalculationJobStream400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		case http.StatusRequestEntityTooLarge:
+			return api.CreateCalculationJobStream413ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		case http.StatusServiceUnavailable:
+			return api.CreateCalculationJobStream503ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return nil, errors.New(err.Message)
+	}
+	return api.CreateCalculationJobStream202JSONResponse{
+		Body: job,
+		Headers: api.CreateCalculationJobStream202ResponseHeaders{
+			Location: calculationJobURL(job.Id),
+		},
+	}, nil
+}
+
+// GetCalculationJob returns the status and the progress of a calculation job.
+func (s *TaxService) GetCalculationJob(ctx context.Context, request api.GetCalculationJobRequestObject) (api.GetCalculationJobResponseObject, error) {
+	job, err := s.Jobs.Get(request.Id)
+	if err != nil {
+		return api.GetCalculationJob404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.GetCalculationJob200JSONResponse(job), nil
+}
+
+// CancelCalculationJob cancels a queued or running calculation job, or deletes a finished one.
+func (s *TaxService) CancelCalculationJob(ctx context.Context, request api.CancelCalculationJobRequestObject) (api.CancelCalculationJobResponseObject, error) {
+	job, err := s.Jobs.Cancel(request.Id)
+	if err != nil {
+		return api.CancelCalculationJob404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.CancelCalculationJob200JSONResponse(job), nil
+}
+
+// GetCalculationJobResults streams the results of a succeeded calculation job.
+func (s *TaxService) GetCalculationJobResults(ctx context.Context, request api.GetCalculationJobResultsRequestObject) (api.GetCalculationJobResultsResponseObject, error) {
+	results, size, err := s.Jobs.Results(request.Id)
+	if err != nil {
+		switch err.Code {
+		case http.StatusNotFound:
+			return api.GetCalculationJobResults404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		case http.StatusConflict:
+			return api.GetCalculationJobResults409ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return nil, errors.New(err.Message)
+	}
+	return api.GetCalculationJobResults200ApplicationxNdjsonResponse{
+		Body:          results,
+		ContentLength: size,
+	}, nil
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"patrickyau/interview-test-server/api"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCalculationJob tests that a job is submitted, executed in the background, downloaded and deleted.
+func TestCalculationJob(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	serve := func(method string, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodPost, "/tax-calculator/jobs", `{"year": "2023", "calculations": [
+		{"id": "e1", "salary": 60000, "province": "ON"},
+		{"id": "e2", "salary": -1},
+		{"id": "e3", "salary": 100000, "jurisdiction": "US", "filing_status": "single"}
+	]}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+	var job api.CalculationJob
+	if err := json.Unmarshal(rec.Body.Bytes(), &job); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if location := rec.Header().Get("Location"); location != "/tax-calculator/jobs/"+job.Id {
+		t.Errorf("got Location %v, want the URL of the job %v", location, job.Id)
+	}
+	if job.Total != 3 || job.Year != "2023" {
+		t.Errorf("got job %+v, want 3 calculations for 2023", job)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for job.Status != api.Succeeded {
+		if time.Now().After(deadline) {
+			t.Fatalf("got job %+v, want it to succeed", job)
+		}
+		time.Sleep(10 * time.Millisecond)
+		rec = serve(http.MethodGet, "/tax-calculator/jobs/"+job.Id, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+		}
+		job = api.CalculationJob{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &job); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+	}
+	if job.Processed != 3 || job.Failed != 1 || job.Progress != 1 || job.FinishedAt == nil || job.ExpiresAt == nil {
+		t.Errorf("got job %+v, want 3 calculations processed and 1 failed", job)
+	}
+
+	rec = serve(http.MethodGet, job.ResultsUrl, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if contentType := rec.Header().Get("Content-Type"); contentType != "application/x-ndjson" {
+		t.Errorf("got content type %v, want application/x-ndjson", contentType)
+	}
+	var results []CalculationJobResult
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var result CalculationJobResult
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		results = append(results, result)
+	}
+	if len(results) != 3 || results[0].ID != "e1" || results[1].ID != "e2" || results[2].ID != "e3" {
+		t.Fatalf("got results %+v, want e1, e2 and e3", results)
+	}
+	taxOwed, taxErr := NewTaxService().calculate("2023", api.CalculateRequest{Salary: 60000, Province: "ON"})
+	if taxErr != nil {
+		t.Fatalf("got error %v, want nil", taxErr)
+	}
+	if want := mapTaxOwedToAPICalculateResponseV2(taxOwed); results[0].Calculation == nil || !reflect.DeepEqual(*results[0].Calculation, want) {
+		t.Errorf("got calculation %+v, want %+v", results[0].Calculation, want)
+	}
+	if results[1].Error == nil || results[1].Error.Field != "salary" || results[1].Calculation != nil {
+		t.Errorf("got result %+v, want an error for the salary", results[1])
+	}
+	if results[2].Calculation == nil || results[2].Calculation.Jurisdiction != JurisdictionUS {
+		t.Errorf("got result %+v, want a US calculation", results[2])
+	}
+
+	rec = serve(http.MethodDelete, "/tax-calculator/jobs/"+job.Id, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	for _, path := range []string{"/tax-calculator/jobs/" + job.Id, job.ResultsUrl} {
+		if rec = serve(http.MethodGet, path, ""); rec.Code != http.StatusNotFound {
+			t.Errorf("got status %v for %v, want %v", rec.Code, path, http.StatusNotFound)
+		}
+	}
+}
+
+// TestCalculationJobInvalid tests that the invalid jobs are refused when they are submitted.
+func TestCalculationJobInvalid(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"unknown year", `{"year": "1999", "calculations": [{"salary": 1}]}`, http.StatusNotFound},
+		{"invalid year", `{"year": "abc", "calculations": [{"salary": 1}]}`, http.StatusBadRequest},
+		{"no calculations", `{"year": "2023", "calculations": []}`, http.StatusBadRequest},
+		{
+			"too many calculations",
+			`{"year": "2023", "calculations": [` + strings.Repeat(`{"salary": 1},`, CalculationJobMaxCalculations) + `{"salary": 1}]}`,
+			http.StatusBadRequest,
+		},
+		{
+			"body too large",
+			`{"year": "2023", "calculations": [{"salary": 1, "id": "` + strings.Repeat("x", MaxRequestBodySize) + `"}]}`,
+			http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tax-calculator/jobs", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if problem := readProblem(t, rec); rec.Code != tt.status || problem.Type != problemTypes[tt.status] {
+				t.Errorf("got status %v and problem type %v, want %v and %v", rec.Code, problem.Type, tt.status, problemTypes[tt.status])
+			}
+		})
+	}
+}
+
+// TestCalculationJobQueue tests that the queued jobs can be cancelled, that the queue is bounded, and that the
+// finished jobs expire.
+func TestCalculationJobQueue(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	s := NewTaxService()
+	s.Clock = func() time.Time { return now }
+	// No worker is started, so the jobs stay queued.
+	s.Jobs.startWorkers.Do(func() {})
+	inputs := []api.CalculationJobInput{{Salary: 50000}}
+
+	queued, err := s.Jobs.Submit("2023", inputs)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if queued.Status != api.Queued {
+		t.Errorf("got status %v, want %v", queued.Status, api.Queued)
+	}
+	if _, _, err := s.Jobs.Results(queued.Id); err == nil || err.Code != http.StatusConflict {
+		t.Errorf("got error %v, want a conflict", err)
+	}
+	cancelled, err := s.Jobs.Cancel(queued.Id)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if cancelled.Status != api.Cancelled || cancelled.FinishedAt == nil {
+		t.Errorf("got job %+v, want it cancelled", cancelled)
+	}
+
+	// The cancelled job still takes a place in the queue until a worker skips it.
+	for i := 1; i < CalculationJobQueueSize; i++ {
+		if _, err := s.Jobs.Submit("2023", inputs); err != nil {
+			t.Fatalf("got error %v for the job %v, want nil", err, i)
+		}
+	}
+	if _, err := s.Jobs.Submit("2023", inputs); err == nil || err.Code != http.StatusServiceUnavailable {
+		t.Errorf("got error %v, want the queue to be full", err)
+	}
+
+	if job, err := s.Jobs.Get(queued.Id); err != nil || job.Status != api.Cancelled {
+		t.Errorf("got job %+v and error %v, want it cancelled", job, err)
+	}
+	now = now.Add(CalculationJobRetention + time.Second)
+	if _, err := s.Jobs.Get(queued.Id); err == nil || err.Code != http.StatusNotFound {
+		t.Errorf("got error %v, want the job to have expired", err)
+	}
+}
+
+// TestCalculationJobCancelRunning tests that a running job stops when it is cancelled.
+func TestCalculationJobCancelRunning(t *testing.T) {
+	s := NewTaxService()
+	var body strings.Builder
+	for i := 0; i < 200000; i++ {
+		fmt.Fprintf(&body, `{"id": "e%d", "salary": %d}`+"\n", i, 30000+i)
+	}
+	job, err := s.Jobs.SubmitStream("2023", strings.NewReader(body.String()))
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for job.Status == api.Queued {
+		if time.Now().After(deadline) {
+			t.Fatalf("got job %+v, want it running", job)
+		}
+		time.Sleep(time.Millisecond)
+		job, _ = s.Jobs.Get(job.Id)
+	}
+	cancelled, err := s.Jobs.Cancel(job.Id)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if cancelled.Status != api.Cancelled || cancelled.Processed == cancelled.Total {
+		t.Errorf("got job %+v, want it cancelled before it finished", cancelled)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if later, _ := s.Jobs.Get(job.Id); later.Status != api.Cancelled || later.ResultsUrl != "" {
+		t.Errorf("got job %+v, want it to stay cancelled without results", later)
+	}
+}
+
+// TestCalculationJobStream tests that a job is streamed as NDJSON, beyond the limits of the JSON bodies, and executed.
+func TestCalculationJobStream(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	submit := func(body string) api.CalculationJob {
+		req := httptest.NewRequest(http.MethodPost, "/tax-calculator/tax-years/2023/jobs", strings.NewReader(body))
+		req.Header.Set("Content-Type", ContentTypeNDJSON)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusAccepted, rec.Body.String())
+		}
+		var job api.CalculationJob
+		if err := json.Unmarshal(rec.Body.Bytes(), &job); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		return job
+	}
+
+	job := submit(`{"id": "e1", "salary": 60000, "province": "ON"}` + "\n\n" + `{"id": "e2", "salary": 100000}` + "\n")
+	if job.Total != 2 || job.Year != "2023" {
+		t.Errorf("got job %+v, want 2 calculations for 2023", job)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for job.Status != api.Succeeded {
+		if time.Now().After(deadline) {
+			t.Fatalf("got job %+v, want it to succeed", job)
+		}
+		time.Sleep(10 * time.Millisecond)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tax-calculator/jobs/"+job.Id, nil))
+		job = api.CalculationJob{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &job); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+	}
+	if job.Processed != 2 || job.Failed != 0 {
+		t.Errorf("got job %+v, want 2 calculations processed", job)
+	}
+
+	// A body larger than MaxRequestBodySize, with more calculations than CalculationJobMaxCalculations.
+	var body strings.Builder
+	for i := 0; body.Len() <= MaxRequestBodySize; i++ {
+		fmt.Fprintf(&body, `{"id": "employee-%06d", "salary": %d, "province": "ON"}`+"\n", i, 30000+i)
+	}
+	job = submit(body.String())
+	if job.Total <= CalculationJobMaxCalculations {
+		t.Errorf("got %v calculations, want more than %v", job.Total, CalculationJobMaxCalculations)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/tax-calculator/jobs/"+job.Id, nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+// TestCalculationJobStreamInvalid tests that the invalid streamed jobs are refused when they are submitted.
+func TestCalculationJobStreamInvalid(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		name   string
+		year   string
+		body   string
+		status int
+		field  string
+	}{
+		{"unknown year", "1999", `{"salary": 1}`, http.StatusNotFound, "year"},
+		{"no calculations", "2023", "\n", http.StatusBadRequest, "calculations"},
+		{"invalid JSON", "2023", `{"salary": 1}` + "\n" + `{"salary": `, http.StatusBadRequest, "calculations[1]"},
+		{"invalid calculation", "2023", `{"salary": 1}` + "\n" + `{"salary": "abc"}`, http.StatusBadRequest, "calculations[1].salary"},
+		{"line too long", "2023", `{"salary": 1, "id": "` + strings.Repeat("x", calculationJobMaxLineSize) + `"}`, http.StatusBadRequest, "calculations[0]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tax-calculator/tax-years/"+tt.year+"/jobs", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", ContentTypeNDJSON)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if problem := readProblem(t, rec); rec.Code != tt.status || problem.Field != tt.field {
+				t.Errorf("got status %v and field %v, want %v and %v: %+v", rec.Code, problem.Field, tt.status, tt.field, problem)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/go-chi/chi/v5"
+)
+
+// Limits of a live calculation connection. A connection that goes over them is closed.
+const (
+	// LiveCalculationDebounce is how long the inputs must stop changing before the latest one is calculated.
+	LiveCalculationDebounce = 300 * time.Millisecond
+	// LiveCalculationMaxInputsPerSecond is the maximum number of inputs a client can send in a second.
+	LiveCalculationMaxInputsPerSecond = 20
+	// LiveCalculationIdleTimeout is how long a connection stays open without inputs.
+	LiveCalculationIdleTimeout = 5 * time.Minute
+	// LiveCalculationMaxDuration is how long a connection stays open at most.
+	LiveCalculationMaxDuration = time.Hour
+	// liveCalculationMaxInputSize is the maximum size of an input.
+	liveCalculationMaxInputSize = 16 << 10
+	// liveCalculationWriteTimeout is how long a result can take to be written before the connection is closed.
+	liveCalculationWriteTimeout = 10 * time.Second
+)
+
+// errTooManyInputs is returned when a client sends more than LiveCalculationMaxInputsPerSecond inputs in a second.
+var errTooManyInputs = fmt.Errorf("more than %v inputs per second", LiveCalculationMaxInputsPerSecond)
+
+// LiveCalculationInput is an input of a live calculation: the body of a calculation request, with a sequence number the
+// client chooses to match the results to its inputs.
+type LiveCalculationInput struct {
+	Sequence int `json:"sequence"`
+	api.CalculateRequest
+}
+
+// LiveCalculationResult is the result of the latest input of a live calculation: either the calculation, in the v2
+// schema, or the problem of an invalid input.
+type LiveCalculationResult struct {
+	Sequence    int                      `json:"sequence"`
+	Calculation *api.CalculateResponseV2 `json:"calculation,omitempty"`
+	Error       *api.ErrorResponses      `json:"error,omitempty"`
+}
+
+// liveInput is an input read from the connection, or the error of an input that is not JSON.
+type liveInput struct {
+	input LiveCalculationInput
+	err   *Err
+}
+
+// LiveCalculationHandler serves the live calculations of a tax year over a WebSocket, where the client sends the
+// inputs as they change and receives the calculation of the latest one once they stop changing.
+type LiveCalculationHandler struct {
+	service *TaxService
+}
+
+// NewLiveCalculationHandler returns the handler of the live calculation endpoint.
+func NewLiveCalculationHandler(service *TaxService) *LiveCalculationHandler {
+	return &LiveCalculationHandler{service: service}
+}
+
+// ServeHTTP checks the tax year, upgrades the request to a WebSocket and calculates the inputs until the client
+// closes the connection, goes over its limits, or the request context is done.
+func (h *LiveCalculationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	year := chi.URLParam(r, "year")
+	if _, err := h.service.taxYear(year); err != nil {
+		WriteProblem(w, r, err)
+		return
+	}
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		// Accept has already written the error response.
+		return
+	}
+	defer conn.CloseNow()
+	conn.SetReadLimit(liveCalculationMaxInputSize)
+
+	ctx, cancel := context.WithTimeout(r.Context(), LiveCalculationMaxDuration)
+	defer cancel()
+
+	inputs := make(chan liveInput)
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- readLiveInputs(ctx, conn, inputs)
+	}()
+
+	// One timer each for the debounce and the idle timeout is reset by every input. The debounce channel is nil while no
+	// input is pending.
+	var pending *liveInput
+	var debounce <-chan time.Time
+	debounceTimer := time.NewTimer(LiveCalculationDebounce)
+	debounceTimer.Stop()
+	defer debounceTimer.Stop()
+	idle := time.NewTimer(LiveCalculationIdleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case input := <-inputs:
+			pending = &input
+			resetTimer(debounceTimer, LiveCalculationDebounce)
+			debounce = debounceTimer.C
+			resetTimer(idle, LiveCalculationIdleTimeout)
+		case <-debounce:
+			result := h.calculate(ctx, year, *pending)
+			pending, debounce = nil, nil
+			writeCtx, cancelWrite := context.WithTimeout(ctx, liveCalculationWriteTimeout)
+			err := wsjson.Write(writeCtx, conn, result)
+			cancelWrite()
+			if err != nil {
+				return
+			}
+		case err := <-readErr:
+			if errors.Is(err, errTooManyInputs) {
+				conn.Close(websocket.StatusPolicyViolation, err.Error())
+			}
+			return
+		case <-idle.C:
+			conn.Close(websocket.StatusPolicyViolation, fmt.Sprintf("no input for %v", LiveCalculationIdleTimeout))
+			return
+		case <-ctx.Done():
+			conn.Close(websocket.StatusGoingAway, "the connection is closed")
+			return
+		}
+	}
+}
+
+// resetTimer resets the timer to the duration, draining the time it sent if it fired and was not received, so the
+// timer only fires once the duration has elapsed again.
+func resetTimer(timer *time.Timer, d time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(d)
+}
+
+// calculate returns the result of an input.
+func (h *LiveCalculationHandler) calculate(ctx context.Context, year string, input liveInput) LiveCalculationResult {
+	result := LiveCalculationResult{Sequence: input.input.Sequence}
+	err := input.err
+	if err == nil {
+		var taxOwed TaxOwed
+		taxOwed, err = h.service.calculate(year, input.input.CalculateRequest)
+		if err == nil {
+			calculation := mapTaxOwedToAPICalculateResponseV2(taxOwed)
+			result.Calculation = &calculation
+			return result
+		}
+	}
+	problem := NewProblem(ctx, err)
+	result.Error = &problem
+	return result
+}
+
+// readLiveInputs reads the inputs of the connection until it fails, e.g. when the client closes it, the context is
+// done, or the client sends more than LiveCalculationMaxInputsPerSecond inputs in a second.
+func readLiveInputs(ctx context.Context, conn *websocket.Conn, inputs chan<- liveInput) error {
+	window, count := time.Now(), 0
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			return err
+		}
+		if now := time.Now(); now.Sub(window) >= time.Second {
+			window, count = now, 0
+		}
+		if count++; count > LiveCalculationMaxInputsPerSecond {
+			return errTooManyInputs
+		}
+
+		var input liveInput
+		if err := json.Unmarshal(data, &input.input); err != nil {
+			input.err = &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "body",
+				Message: fmt.Sprintf("the input is not a calculation request: %v", err),
+			}
+		}
+		select {
+		case inputs <- input:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
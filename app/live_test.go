@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"patrickyau/interview-test-server/api"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+)
+
+// dialLiveCalculation serves the router and opens a live calculation connection for the year.
+func dialLiveCalculation(t *testing.T, year string) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/tax-calculator/tax-years/" + year + "/calculate/live"
+	conn, response, err := websocket.Dial(context.Background(), url, nil)
+	if conn != nil {
+		t.Cleanup(func() { conn.CloseNow() })
+	}
+	return conn, response, err
+}
+
+// TestLiveCalculation tests that only the latest of successive inputs is calculated, once they stop changing.
+func TestLiveCalculation(t *testing.T) {
+	conn, _, err := dialLiveCalculation(t, "2023")
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i, salary := range []float64{1, 10, 100, 1000, 10000, 100000} {
+		input := LiveCalculationInput{Sequence: i + 1, CalculateRequest: api.CalculateRequest{Salary: salary, Province: "ON"}}
+		if err := wsjson.Write(ctx, conn, input); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+	}
+	var result LiveCalculationResult
+	if err := wsjson.Read(ctx, conn, &result); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if result.Sequence != 6 || result.Error != nil || result.Calculation == nil {
+		t.Fatalf("got result %+v, want the calculation of the input 6", result)
+	}
+	taxOwed, taxErr := NewTaxService().calculate("2023", api.CalculateRequest{Salary: 100000, Province: "ON"})
+	if taxErr != nil {
+		t.Fatalf("got error %v, want nil", taxErr)
+	}
+	if want := mapTaxOwedToAPICalculateResponseV2(taxOwed); !reflect.DeepEqual(*result.Calculation, want) {
+		t.Errorf("got %+v, want %+v", *result.Calculation, want)
+	}
+
+	// The next inputs are calculated on the same connection, and an invalid input gets a problem.
+	for _, tt := range []struct {
+		input string
+		field string
+	}{
+		{`{"sequence": 7, "salary": -1}`, "salary"},
+		{`{"sequence": 8, "salary": "abc"}`, "body"},
+	} {
+		if err := conn.Write(ctx, websocket.MessageText, []byte(tt.input)); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		var result LiveCalculationResult
+		if err := wsjson.Read(ctx, conn, &result); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		if result.Error == nil || result.Error.Status != http.StatusBadRequest || result.Error.Field != tt.field {
+			t.Errorf("got result %+v for %v, want a problem for the field %v", result, tt.input, tt.field)
+		}
+	}
+	conn.Close(websocket.StatusNormalClosure, "")
+}
+
+// TestLiveCalculationYear tests that the year is checked before the connection is upgraded.
+func TestLiveCalculationYear(t *testing.T) {
+	var tests = []struct {
+		year   string
+		status int
+	}{
+		{"1999", http.StatusNotFound},
+		{"abc", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.year, func(t *testing.T) {
+			_, response, err := dialLiveCalculation(t, tt.year)
+			if err == nil {
+				t.Fatalf("got no error, want one")
+			}
+			if response == nil || response.StatusCode != tt.status {
+				t.Fatalf("got response %v, want status %v", response, tt.status)
+			}
+			if contentType := response.Header.Get("Content-Type"); contentType != ContentTypeProblemJSON {
+				t.Errorf("got content type %v, want %v", contentType, ContentTypeProblemJSON)
+			}
+		})
+	}
+}
+
+// TestLiveCalculationLimits tests that a client sending too many inputs is disconnected.
+func TestLiveCalculationLimits(t *testing.T) {
+	conn, _, err := dialLiveCalculation(t, "2023")
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i <= LiveCalculationMaxInputsPerSecond; i++ {
+		if err := wsjson.Write(ctx, conn, LiveCalculationInput{Sequence: i, CalculateRequest: api.CalculateRequest{Salary: 50000}}); err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+	}
+	_, _, err = conn.Read(ctx)
+	if status := websocket.CloseStatus(err); status != websocket.StatusPolicyViolation {
+		t.Errorf("got close status %v (%v), want %v", status, err, websocket.StatusPolicyViolation)
+	}
+}
+
+// TestResetTimer tests that a reset timer fires once the duration has elapsed again, even if it had fired before.
+func TestResetTimer(t *testing.T) {
+	timer := time.NewTimer(time.Millisecond)
+	defer timer.Stop()
+	time.Sleep(10 * time.Millisecond)
+
+	reset := time.Now()
+	resetTimer(timer, 50*time.Millisecond)
+	fired := <-timer.C
+	if elapsed := fired.Sub(reset); elapsed < 50*time.Millisecond {
+		t.Errorf("got the timer fired after %v, want at least %v", elapsed, 50*time.Millisecond)
+	}
+}
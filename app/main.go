@@ -1,26 +1,28 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"math"
+	"net"
 	"net/http"
+	"os"
 	"patrickyau/interview-test-server/api"
 	"strconv"
+	"strings"
 	"time"
 
 	// Import the ginzerolog package
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
 	"github.com/go-openapi/runtime/middleware"
-	chimiddleware "github.com/oapi-codegen/nethttp-middleware"
 
 	"github.com/go-chi/chi/v5"
 	chimiddleware2 "github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/httplog/v2"
-	"github.com/go-chi/render"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
@@ -28,20 +30,71 @@ import (
 func main() {
 	zerolog.TimeFieldFormat = time.RFC3339 //zerolog.TimeFormatUnix
 
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImport(os.Args[2:], os.Stdout); err != nil {
+			log.Fatal().Msgf("error: %v", err)
+		}
+		return
+	}
+
+	// The HTTP and gRPC servers share the service, so they serve the same jobs and clock.
 	service := NewTaxService()
+	apiServer, err := NewRouter(service)
+	if err != nil {
+		log.Fatal().Msgf("error: %v", err)
+	}
+
+	grpcListener, err := net.Listen("tcp", GRPCAddr)
+	if err != nil {
+		log.Fatal().Msgf("error: %v", err)
+	}
+	grpcServer := NewGRPCServer(service)
+	go func() {
+		log.Info().Msgf("gRPC server listening on %v", GRPCAddr)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatal().Msgf("error: %v", err)
+		}
+	}()
+
+	addr := ":8080"
+	httpServer := http.Server{
+		Addr:    addr,
+		Handler: apiServer,
+	}
+
+	log.Info().Msgf("Server listening on %v", addr)
+	err = httpServer.ListenAndServe()
+	if err != nil {
+		log.Fatal().Msgf("error: %v", err)
+		return
+	}
+}
+
+// MaxRequestBodySize is the maximum size of a request body read in memory, which fits a job of
+// CalculationJobMaxCalculations calculations.
+const MaxRequestBodySize = 8 << 20
+
+// DevelopmentMode reports whether the service runs in development, i.e. the APP_ENV environment variable is
+// development, which serves the GraphiQL page.
+func DevelopmentMode() bool {
+	return os.Getenv("APP_ENV") == "development"
+}
+
+// NewRouter returns the HTTP handler of the service, with its middlewares, swagger UI and request validation.
+func NewRouter(service *TaxService) (http.Handler, error) {
 	s := NewServer(service)
 	swagger, err := api.GetSwagger()
 	if err != nil {
-		log.Fatal().Msgf("error: %v", err)
+		return nil, err
 	}
 
 	router := chi.NewRouter()
 	// chimiddleware2.Logger.WithLogger(zerolog.New(os.Stdout).With().Timestamp().Logger())
 	router.Use(chimiddleware2.Logger)
 	router.Use(chimiddleware2.RequestID)
-	router.Use(chimiddleware2.Timeout(60 * time.Second))
+	router.Use(NewRecovererMiddleware())
 	router.Use(chimiddleware2.URLFormat)
-	router.Use(render.SetContentType(render.ContentTypeJSON))
+	router.Use(NewContentNegotiationMiddleware())
 	// logger := httplog.NewLogger("httplog-example", httplog.Options{
 	// 	// JSON:             true,
 	// 	LogLevel:         slog.LevelDebug,
@@ -62,63 +115,79 @@ func main() {
 	// })
 	// router.Use(httplog.RequestLogger(logger))
 
-	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
+	// The live calculations are long-lived WebSocket connections, bounded by their own limits instead of the timeout of
+	// the other routes.
+	router.Handle("/tax-calculator/tax-years/{year}/calculate/live", NewLiveCalculationHandler(service))
+	timed := router.With(NewTimeoutMiddleware(60*time.Second), NewBodyLimitMiddleware(MaxRequestBodySize))
+
+	timed.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("Welcome to PY's Tax Calculator API"))
 	})
 
 	// Add swagger UI endpoints
-	router.Get("/swagger/doc.json", func(w http.ResponseWriter, r *http.Request) {
+	timed.Get("/swagger/doc.json", func(w http.ResponseWriter, r *http.Request) {
 		json.NewEncoder(w).Encode(swagger)
 	})
-	router.Handle("/swagger/", middleware.SwaggerUI(middleware.SwaggerUIOpts{
+	timed.Handle("/swagger/", middleware.SwaggerUI(middleware.SwaggerUIOpts{
 		Path:    "/swagger/",
 		SpecURL: "/swagger/doc.json",
 	}, nil))
 
 	// Enable validation of incoming requests
-	validator := chimiddleware.OapiRequestValidatorWithOptions(
-		swagger,
-		&chimiddleware.Options{
-			Options: openapi3filter.Options{
-				AuthenticationFunc: func(c context.Context, input *openapi3filter.AuthenticationInput) error {
-					return nil
-				},
-			},
-		},
-	)
+	validator, err := NewValidatorMiddleware(swagger)
+	if err != nil {
+		return nil, err
+	}
 
 	securityMiddleware := NewSecurityMiddleware()
 
-	apiServer := api.HandlerWithOptions(
-		api.NewStrictHandler(s, nil),
+	graphQLHandler, err := NewGraphQLHandler(service, DevelopmentMode())
+	if err != nil {
+		return nil, err
+	}
+	timed.Handle("/tax-calculator/graphql", graphQLHandler)
+
+	router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		WriteProblem(w, r, &Err{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("the path %v is not found", r.URL.Path),
+		})
+	})
+	router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		WriteProblem(w, r, &Err{
+			Code:    http.StatusMethodNotAllowed,
+			Message: fmt.Sprintf("the method %v is not allowed on the path %v", r.Method, r.URL.Path),
+		})
+	})
+
+	strictHandler := api.NewStrictHandlerWithOptions(s, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			WriteProblem(w, r, bodyError(err))
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Error().Err(err).Str("request_id", chimiddleware2.GetReqID(r.Context())).Msg("error writing the response")
+			WriteProblem(w, r, &Err{
+				Code:    http.StatusInternalServerError,
+				Message: "the response could not be written",
+			})
+		},
+	})
+
+	api.HandlerWithOptions(
+		strictHandler,
 		api.ChiServerOptions{
 			BaseURL:    "/tax-calculator",
-			BaseRouter: router,
+			BaseRouter: timed,
 			Middlewares: []api.MiddlewareFunc{
 				securityMiddleware,
 				validator,
 			},
 			ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-				oplog := httplog.LogEntry(r.Context())
-				oplog.Error("msg here", "err", errors.New("err here"))
-				w.WriteHeader(500)
-				w.Write([]byte("oops, err"))
+				WriteProblem(w, r, paramError(err))
 			},
 		},
 	)
-
-	addr := ":8080"
-	httpServer := http.Server{
-		Addr:    addr,
-		Handler: apiServer,
-	}
-
-	log.Info().Msgf("Server listening on %v", addr)
-	err = httpServer.ListenAndServe()
-	if err != nil {
-		log.Fatal().Msgf("error: %v", err)
-		return
-	}
+	return router, nil
 }
 
 func NewServer(taxService *TaxService) api.StrictServerInterface {
@@ -128,10 +197,18 @@ func NewServer(taxService *TaxService) api.StrictServerInterface {
 }
 
 type TaxService struct {
+	// Clock returns the time symbolic years such as current are resolved at.
+	Clock func() time.Time
+	// Jobs executes the calculation jobs in the background.
+	Jobs *CalculationJobs
 }
 
 func NewTaxService() *TaxService {
-	return &TaxService{}
+	s := &TaxService{
+		Clock: time.Now,
+	}
+	s.Jobs = NewCalculationJobs(s)
+	return s
 }
 
 type server struct {
@@ -145,20 +222,51 @@ func (s *TaxService) Check(ctx context.Context, request api.CheckRequestObject)
 	}, nil
 }
 
-// GetTaxCalculator returns the tax brackets for the default year 2022.
+// GetTaxCalculator returns the tax brackets for the latest year.
 func (s *TaxService) GetTaxCalculator(ctx context.Context, request api.GetTaxCalculatorRequestObject) (api.GetTaxCalculatorResponseObject, error) {
-	taxBrackets, err := GetTaxCalculatorInstructionsByYear("")
+	year := LatestTaxYear()
+	taxBrackets, err := GetTaxCalculatorInstructionsByYear(year)
 	if err != nil {
 		// c.IndentedJSON(http.StatusNotFound, err)
-		return api.GetTaxCalculator400JSONResponse{
-			Code:    err.Code,
-			Field:   err.Field,
-			Message: err.Message,
+		return api.GetTaxCalculator400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	contentType := NegotiatedContentType(ctx, encodedContentTypes...)
+	cacheHeaders := NewCacheHeaders(YearLatest, year, contentType)
+	if cacheHeaders.NotModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return api.GetTaxCalculator304Response{
+			Headers: api.GetTaxCalculator304ResponseHeaders{
+				CacheControl: cacheHeaders.CacheControl,
+				ETag:         cacheHeaders.ETag,
+				LastModified: cacheHeaders.LastModified,
+				Vary:         "Accept",
+			},
 		}, nil
 	}
-	var response api.GetTaxCalculator200JSONResponse
+	response := api.GetTaxCalculator200JSONResponse{
+		Headers: api.GetTaxCalculator200ResponseHeaders{
+			XTaxYear:     year,
+			CacheControl: cacheHeaders.CacheControl,
+			ETag:         cacheHeaders.ETag,
+			LastModified: cacheHeaders.LastModified,
+			Vary:         "Accept",
+		},
+	}
 	for _, bracket := range taxBrackets {
-		response = append(response, mapTaxBracketToAPITaxBracket(bracket))
+		response.Body = append(response.Body, mapTaxBracketToAPITaxBracket(bracket))
+	}
+	if contentType != ContentTypeJSON {
+		encoded, err := EncodeResponse(contentType, taxBracketsRepresentation, response.Body)
+		if err != nil {
+			return nil, err
+		}
+		encodedResponse := newEncodedOperationResponse[api.GetTaxCalculator200TextcsvResponse](encoded, response.Headers)
+		switch contentType {
+		case ContentTypeXML:
+			return api.GetTaxCalculator200ApplicationxmlResponse(encodedResponse), nil
+		case ContentTypeYAML:
+			return api.GetTaxCalculator200ApplicationyamlResponse(encodedResponse), nil
+		}
+		return encodedResponse, nil
 	}
 	return response, nil
 }
@@ -178,27 +286,68 @@ func mapTaxBracketToAPITaxBracket(taxBracket TaxBracket) api.TaxBracket {
 
 // GetTaxCalculatorByYear returns the tax brackets for the given year.
 func (s *TaxService) GetTaxCalculatorByYear(ctx context.Context, request api.GetTaxCalculatorByYearRequestObject) (api.GetTaxCalculatorByYearResponseObject, error) {
+	year, err := s.resolveYear(request.Year)
+	if err != nil {
+		return api.GetTaxCalculatorByYear404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
 
-	if err := ValidateYear(request.Year); err != nil {
-		return api.GetTaxCalculatorByYear400JSONResponse{
-			Code:    http.StatusBadRequest,
-			Field:   "year",
-			Message: fmt.Sprintf("the tax year %v is not a valid year", request.Year),
-		}, nil
+	if err := ValidateYear(year); err != nil {
+		return api.GetTaxCalculatorByYear400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
 	}
 
-	taxBrackets, err := GetTaxCalculatorInstructionsByYear(request.Year)
+	taxBrackets, err := GetTaxCalculatorInstructionsByYear(year)
 	if err != nil {
-		return api.GetTaxCalculatorByYear404JSONResponse{
-			Code:    err.Code,
-			Field:   err.Field,
-			Message: err.Message,
-		}, nil
+		return api.GetTaxCalculatorByYear404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	provenance, err := GetTaxYearProvenance(year)
+	if err != nil {
+		return api.GetTaxCalculatorByYear404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
 	}
 
-	var response api.GetTaxCalculatorByYear200JSONResponse
+	contentType := NegotiatedContentType(ctx, encodedContentTypes...)
+	cacheHeaders := NewCacheHeaders(request.Year, year, contentType)
+	if cacheHeaders.NotModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return api.GetTaxCalculatorByYear304Response{
+			Headers: api.GetTaxCalculatorByYear304ResponseHeaders{
+				CacheControl: cacheHeaders.CacheControl,
+				ETag:         cacheHeaders.ETag,
+				LastModified: cacheHeaders.LastModified,
+				Vary:         "Accept",
+			},
+		}, nil
+	}
+	response := api.GetTaxCalculatorByYear200JSONResponse{
+		Headers: api.GetTaxCalculatorByYear200ResponseHeaders{
+			XTaxYear:        year,
+			Link:            provenanceLink(year),
+			XDatasetVersion: DatasetVersion,
+			CacheControl:    cacheHeaders.CacheControl,
+			ETag:            cacheHeaders.ETag,
+			LastModified:    cacheHeaders.LastModified,
+			Vary:            "Accept",
+		},
+		Body: api.TaxYearResponse{
+			Year:           year,
+			Provenance:     mapProvenanceToAPITaxYearProvenance(year, provenance),
+			DatasetVersion: DatasetVersion,
+		},
+	}
 	for _, bracket := range taxBrackets {
-		response = append(response, mapTaxBracketToAPITaxBracket(bracket))
+		response.Body.Brackets = append(response.Body.Brackets, mapTaxBracketToAPITaxBracket(bracket))
+	}
+	if contentType != ContentTypeJSON {
+		encoded, err := EncodeResponse(contentType, taxYearRepresentation, response.Body)
+		if err != nil {
+			return nil, err
+		}
+		encodedResponse := newEncodedOperationResponse[api.GetTaxCalculatorByYear200TextcsvResponse](encoded, response.Headers)
+		switch contentType {
+		case ContentTypeXML:
+			return api.GetTaxCalculatorByYear200ApplicationxmlResponse(encodedResponse), nil
+		case ContentTypeYAML:
+			return api.GetTaxCalculatorByYear200ApplicationyamlResponse(encodedResponse), nil
+		}
+		return encodedResponse, nil
 	}
 	return response, nil
 }
@@ -214,50 +363,413 @@ func mapTaxBracketsToAPITaxBrackets(taxBrackets []TaxBracket) []api.TaxBracket {
 
 // GetAllTaxCalculator returns all tax brackets for all supported years.
 func (s *TaxService) GetAllTaxCalculator(ctx context.Context, request api.GetAllTaxCalculatorRequestObject) (api.GetAllTaxCalculatorResponseObject, error) {
-	response := api.GetAllTaxCalculator200JSONResponse{}
+	contentType := NegotiatedContentType(ctx, encodedContentTypes...)
+	cacheHeaders := NewCacheHeaders("", "", contentType)
+	if cacheHeaders.NotModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return api.GetAllTaxCalculator304Response{
+			Headers: api.GetAllTaxCalculator304ResponseHeaders{
+				CacheControl: cacheHeaders.CacheControl,
+				ETag:         cacheHeaders.ETag,
+				LastModified: cacheHeaders.LastModified,
+				Vary:         "Accept",
+			},
+		}, nil
+	}
+	response := api.GetAllTaxCalculator200JSONResponse{
+		Body: api.AllTaxBracketResponses{},
+		Headers: api.GetAllTaxCalculator200ResponseHeaders{
+			XDatasetVersion: DatasetVersion,
+			CacheControl:    cacheHeaders.CacheControl,
+			ETag:            cacheHeaders.ETag,
+			LastModified:    cacheHeaders.LastModified,
+			Vary:            "Accept",
+		},
+	}
 	for year, taxBrackets := range TaxBrackets {
-		response[year] = mapTaxBracketsToAPITaxBrackets(taxBrackets)
+		response.Body[year] = mapTaxBracketsToAPITaxBrackets(taxBrackets)
+	}
+	if contentType != ContentTypeJSON {
+		encoded, err := EncodeResponse(contentType, taxYearsRepresentation, response.Body)
+		if err != nil {
+			return nil, err
+		}
+		encodedResponse := newEncodedOperationResponse[api.GetAllTaxCalculator200TextcsvResponse](encoded, response.Headers)
+		switch contentType {
+		case ContentTypeXML:
+			return api.GetAllTaxCalculator200ApplicationxmlResponse(encodedResponse), nil
+		case ContentTypeYAML:
+			return api.GetAllTaxCalculator200ApplicationyamlResponse(encodedResponse), nil
+		}
+		return encodedResponse, nil
 	}
 	return response, nil
 }
 
 // Calculate calculates the tax for the year from JSON received in the request body.
 func (s *TaxService) Calculate(ctx context.Context, request api.CalculateRequestObject) (api.CalculateResponseObject, error) {
-	salary := request.Body.Salary
-	err := ValidateSalary(salary)
+	taxOwed, err := s.calculate(request.Year, *request.Body)
 	if err != nil {
-		return api.Calculate400JSONResponse{
-			Code:    err.Code,
-			Field:   err.Field,
-			Message: err.Message,
-		}, nil
+		if err.Code == http.StatusNotFound {
+			return api.Calculate404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.Calculate400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	response := api.Calculate200JSONResponse{
+		Body: mapTaxOwedToAPICalculateResponse(taxOwed),
+		Headers: api.Calculate200ResponseHeaders{
+			XTaxYear: taxOwed.TaxYear,
+		},
+	}
+	contentType, encoded, encodeErr := s.encodeCalculation(ctx, taxOwed, response.Body)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	if contentType == ContentTypeJSON {
+		return response, nil
+	}
+	encodedResponse := newEncodedOperationResponse[api.Calculate200TextcsvResponse](encoded, response.Headers)
+	switch contentType {
+	case ContentTypeXML:
+		return api.Calculate200ApplicationxmlResponse(encodedResponse), nil
+	case ContentTypeYAML:
+		return api.Calculate200ApplicationyamlResponse(encodedResponse), nil
+	case ContentTypePDF:
+		return api.Calculate200ApplicationpdfResponse(encodedResponse), nil
+	}
+	return encodedResponse, nil
+}
+
+// GetCalculation calculates the tax for the year from the query parameters, so the calculation can be bookmarked and
+// cached.
+func (s *TaxService) GetCalculation(ctx context.Context, request api.GetCalculationRequestObject) (api.GetCalculationResponseObject, error) {
+	taxOwed, err := s.calculate(request.Year, mapGetCalculationParamsToCalculateRequest(request.Params))
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.GetCalculation404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.GetCalculation400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	response := api.GetCalculation200JSONResponse{
+		Body: mapTaxOwedToAPICalculateResponse(taxOwed),
+		Headers: api.GetCalculation200ResponseHeaders{
+			CacheControl:    cacheControl(request.Year, taxOwed.TaxYear),
+			Vary:            "Accept",
+			XDatasetVersion: DatasetVersion,
+			XTaxYear:        taxOwed.TaxYear,
+		},
+	}
+	contentType, encoded, encodeErr := s.encodeCalculation(ctx, taxOwed, response.Body)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	if contentType == ContentTypeJSON {
+		return response, nil
+	}
+	encodedResponse := newEncodedOperationResponse[api.GetCalculation200TextcsvResponse](encoded, response.Headers)
+	switch contentType {
+	case ContentTypeXML:
+		return api.GetCalculation200ApplicationxmlResponse(encodedResponse), nil
+	case ContentTypeYAML:
+		return api.GetCalculation200ApplicationyamlResponse(encodedResponse), nil
+	case ContentTypePDF:
+		return api.GetCalculation200ApplicationpdfResponse(encodedResponse), nil
+	}
+	return encodedResponse, nil
+}
+
+// encodeCalculation encodes a calculation in the negotiated content type, or returns JSON for the strict handler to
+// encode.
+func (s *TaxService) encodeCalculation(ctx context.Context, taxOwed TaxOwed, response api.CalculateResponse) (string, EncodedResponse, error) {
+	contentType := NegotiatedContentType(ctx, append(encodedContentTypes, ContentTypePDF)...)
+	switch contentType {
+	case ContentTypeJSON:
+		return contentType, EncodedResponse{}, nil
+	case ContentTypePDF:
+		var report bytes.Buffer
+		if err := WriteCalculationReport(&report, taxOwed, s.Clock()); err != nil {
+			return "", EncodedResponse{}, err
+		}
+		return contentType, EncodedResponse{Body: &report, ContentLength: int64(report.Len())}, nil
+	}
+	encoded, err := EncodeResponse(contentType, calculationRepresentation, response)
+	return contentType, encoded, err
+}
+
+// mapGetCalculationParamsToCalculateRequest maps the query parameters of a calculation to its request body.
+func mapGetCalculationParamsToCalculateRequest(params api.GetCalculationParams) api.CalculateRequest {
+	return api.CalculateRequest{
+		Salary:               params.Salary,
+		Jurisdiction:         valueOf(params.Jurisdiction),
+		FilingStatus:         valueOf(params.FilingStatus),
+		Province:             valueOf(params.Province),
+		Deductions:           valueOf(params.Deductions),
+		TaxWithheld:          valueOf(params.TaxWithheld),
+		InstalmentsPaid:      valueOf(params.InstalmentsPaid),
+		ResidencyStartDate:   params.ResidencyStartDate,
+		ResidencyEndDate:     params.ResidencyEndDate,
+		NonResident:          valueOf(params.NonResident),
+		CanadianSourceIncome: valueOf(params.CanadianSourceIncome),
+	}
+}
+
+// valueOf returns the value of an optional parameter, or the zero value when it is not given.
+func valueOf[T any](pointer *T) T {
+	var value T
+	if pointer != nil {
+		value = *pointer
+	}
+	return value
+}
+
+// calculate validates the calculation input and calculates the tax owed for the year.
+func (s *TaxService) calculate(year string, input api.CalculateRequest) (TaxOwed, *Err) {
+	salary := input.Salary
+	if err := ValidateSalary(salary); err != nil {
+		return TaxOwed{}, err
+	}
+
+	year, err := s.resolveYear(year)
+	if err != nil {
+		return TaxOwed{}, err
 	}
 
-	year := request.Year
 	if err := ValidateYear(year); err != nil {
-		return api.Calculate400JSONResponse{
-			Code:    http.StatusBadRequest,
-			Field:   "year",
-			Message: fmt.Sprintf("the tax year %v is not a valid year", request.Year),
-		}, nil
+		return TaxOwed{}, err
 	}
 
-	taxBrackets, err := GetTaxCalculatorInstructionsByYear(year)
+	jurisdiction := input.Jurisdiction
+	if jurisdiction == "" {
+		jurisdiction = JurisdictionCanada
+	}
+	if err := ValidateJurisdiction(jurisdiction); err != nil {
+		return TaxOwed{}, err
+	}
+	if err := ValidateFilingStatus(jurisdiction, input.FilingStatus); err != nil {
+		return TaxOwed{}, err
+	}
+	if err := ValidateProvince(jurisdiction, input.Province); err != nil {
+		return TaxOwed{}, err
+	}
+	incomeItems := make([]IncomeItem, len(input.IncomeItems))
+	totalIncome := salary
+	for i, item := range input.IncomeItems {
+		incomeItem, err := ConvertIncomeItem(year, jurisdiction, item.Description, item.Amount, item.Currency)
+		if err != nil {
+			err.Field = fmt.Sprintf("income_items[%d].%v", i, err.Field)
+			return TaxOwed{}, err
+		}
+		incomeItems[i] = incomeItem
+		totalIncome += incomeItem.ConvertedAmount
+	}
+	if err := ValidateDeductions(totalIncome, input.Deductions); err != nil {
+		return TaxOwed{}, err
+	}
+	if err := ValidatePayment("tax_withheld", input.TaxWithheld); err != nil {
+		return TaxOwed{}, err
+	}
+	if err := ValidatePayment("instalments_paid", input.InstalmentsPaid); err != nil {
+		return TaxOwed{}, err
+	}
+
+	residency, err := NewResidency(jurisdiction, year, input)
 	if err != nil {
-		return api.Calculate400JSONResponse{
-			Code:    err.Code,
-			Field:   err.Field,
-			Message: err.Message,
-		}, nil
+		return TaxOwed{}, err
+	}
+
+	var calc *CalculationContext
+	switch jurisdiction {
+	case JurisdictionUS:
+		filingStatusBrackets, err := GetUSTaxCalculatorInstructionsByYear(year, input.FilingStatus)
+		if err != nil {
+			return TaxOwed{}, err
+		}
+		calc = NewCalculationContext(year, jurisdiction, salary, filingStatusBrackets.Brackets)
+		calc.FilingStatus = input.FilingStatus
+		calc.StandardDeduction = filingStatusBrackets.StandardDeduction
+	default:
+		taxBrackets, err := GetTaxCalculatorInstructionsByYear(year)
+		if err != nil {
+			return TaxOwed{}, err
+		}
+		calc = NewCalculationContext(year, jurisdiction, salary, taxBrackets)
+		if input.Province != "" {
+			provincialBrackets, err := GetProvincialTaxCalculatorInstructionsByYear(input.Province, year)
+			if err != nil {
+				return TaxOwed{}, err
+			}
+			calc.Province = input.Province
+			calc.Provincial = TaxLevel{
+				Jurisdiction: ProvincialJurisdiction(input.Province),
+				Brackets:     provincialBrackets,
+			}
+		}
+	}
+	calc.IncomeItems = incomeItems
+	calc.Deductions = input.Deductions
+	calc.TaxableIncome = totalIncome - input.Deductions
+	if residency.Status == ResidencyStatusNonResident {
+		calc.ContributionEarnings = input.CanadianSourceIncome
+		calc.TaxableIncome = math.Max(input.CanadianSourceIncome-input.Deductions, 0)
+	}
+	if residency.Status != ResidencyStatusResident {
+		calc.Residency = &residency
+	}
+	calc.CreditProrationFactor = residency.CreditProrationFactor
+	calc.TaxWithheld = input.TaxWithheld
+	calc.InstalmentsPaid = input.InstalmentsPaid
+
+	taxOwed, err := CalculateTax(calc)
+	if err != nil {
+		return TaxOwed{}, err
+	}
+	if jurisdiction == JurisdictionCanada {
+		if provenance, err := GetTaxYearProvenance(year); err == nil {
+			taxOwed.Provenance = &provenance
+		}
+	}
+	taxOwed.DatasetVersion = DatasetVersion
+	return taxOwed, nil
+}
+
+// mapTaxOwedToAPICalculateResponse maps a TaxOwed to an api.CalculateResponse.
+func mapTaxOwedToAPICalculateResponse(taxOwed TaxOwed) api.CalculateResponse {
+	response := api.CalculateResponse{
+		TaxYear:           taxOwed.TaxYear,
+		Salary:            taxOwed.Salary,
+		EffectiveTaxRate:  taxOwed.EffectiveTaxRate,
+		TotalTaxOwed:      taxOwed.TotalTaxOwed,
+		TaxOwedPerBand:    mapTaxBracketsToAPITaxBrackets(taxOwed.TaxOwedPerBand),
+		Jurisdiction:      taxOwed.Jurisdiction,
+		FilingStatus:      taxOwed.FilingStatus,
+		StandardDeduction: taxOwed.StandardDeduction,
+		TaxableIncome:     taxOwed.TaxableIncome,
+		Deductions:        taxOwed.Deductions,
+		NetIncome:         taxOwed.NetIncome,
+	}
+	if len(taxOwed.IncomeItems) > 0 {
+		response.TotalIncome = taxOwed.TotalIncome
+		response.IncomeItems = mapIncomeItemsToAPIIncomeItems(taxOwed.IncomeItems)
+	}
+	if taxOwed.TaxWithheld > 0 || taxOwed.InstalmentsPaid > 0 {
+		response.TaxWithheld = taxOwed.TaxWithheld
+		response.InstalmentsPaid = taxOwed.InstalmentsPaid
+		refund, balanceOwing := taxOwed.Refund, taxOwed.BalanceOwing
+		response.Refund = &refund
+		response.BalanceOwing = &balanceOwing
+	}
+	if taxOwed.Province != "" {
+		response.Province = taxOwed.Province
+		response.FederalTaxOwed = taxOwed.FederalTaxOwed
+		response.ProvincialTaxOwed = taxOwed.ProvincialTaxOwed
+		response.ProvincialTaxOwedPerBand = mapTaxBracketsToAPITaxBrackets(taxOwed.ProvincialTaxOwedPerBand)
+		response.ProvincialTaxAuthority = ProvincialTaxAuthority(taxOwed.Province)
+	}
+	if taxOwed.Residency != nil {
+		response.Residency = &api.Residency{
+			Status:                taxOwed.Residency.Status,
+			DaysResident:          taxOwed.Residency.DaysResident,
+			DaysInYear:            taxOwed.Residency.DaysInYear,
+			CreditProrationFactor: taxOwed.Residency.CreditProrationFactor,
+			IncomeProrationFactor: taxOwed.Residency.IncomeProrationFactor,
+		}
+	}
+	response.Rounding = &api.RoundingPolicy{
+		Mode:                  taxOwed.Rounding.Mode,
+		Method:                taxOwed.Rounding.Method,
+		Precision:             taxOwed.Rounding.Precision,
+		EffectiveRateDecimals: taxOwed.Rounding.EffectiveRateDecimals,
+	}
+	if taxOwed.Provenance != nil {
+		provenance := mapProvenanceToAPITaxYearProvenance(taxOwed.TaxYear, *taxOwed.Provenance)
+		response.Provenance = &provenance
 	}
+	response.DatasetVersion = taxOwed.DatasetVersion
+	response.Credits = mapAdjustmentsToAPIAdjustments(taxOwed.Credits)
+	response.Contributions = mapAdjustmentsToAPIAdjustments(taxOwed.Contributions)
+	return response
+}
 
-	taxOwed := CalculateTaxAmount(year, taxBrackets, salary)
-	return api.Calculate200JSONResponse{
-		TaxYear:          taxOwed.TaxYear,
-		Salary:           taxOwed.Salary,
-		EffectiveTaxRate: taxOwed.EffectiveTaxRate,
-		TotalTaxOwed:     taxOwed.TotalTaxOwed,
-		TaxOwedPerBand:   mapTaxBracketsToAPITaxBrackets(taxOwed.TaxOwnedPerBand),
+// mapAdjustmentsToAPIAdjustments maps a slice of Adjustment to a slice of api.Adjustment.
+func mapAdjustmentsToAPIAdjustments(adjustments []Adjustment) []api.Adjustment {
+	if len(adjustments) == 0 {
+		return nil
+	}
+	apiAdjustments := make([]api.Adjustment, len(adjustments))
+	for i, adjustment := range adjustments {
+		apiAdjustments[i] = api.Adjustment{
+			Name:   adjustment.Name,
+			Amount: adjustment.Amount,
+		}
+	}
+	return apiAdjustments
+}
+
+// mapIncomeItemsToAPIIncomeItems maps a slice of IncomeItem to a slice of api.IncomeItem.
+func mapIncomeItemsToAPIIncomeItems(incomeItems []IncomeItem) []api.IncomeItem {
+	apiIncomeItems := make([]api.IncomeItem, len(incomeItems))
+	for i, incomeItem := range incomeItems {
+		apiIncomeItems[i] = api.IncomeItem{
+			Description:     incomeItem.Description,
+			Amount:          incomeItem.Amount,
+			Currency:        incomeItem.Currency,
+			ExchangeRate:    incomeItem.ExchangeRate,
+			ConvertedAmount: incomeItem.ConvertedAmount,
+		}
+	}
+	return apiIncomeItems
+}
+
+// NewValidatorMiddleware returns a middleware validating the requests against the OpenAPI spec. The invalid
+// requests are reported as problem details, with every invalid parameter and body field.
+func NewValidatorMiddleware(swagger *openapi3.T) (func(http.Handler) http.Handler, error) {
+	specRouter, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, err
+	}
+	options := &openapi3filter.Options{
+		MultiError: true,
+		AuthenticationFunc: func(c context.Context, input *openapi3filter.AuthenticationInput) error {
+			return nil
+		},
+	}
+	// The streamed calculation jobs are validated line by line by their handler, as the validation reads the body in
+	// memory.
+	streamOptions := *options
+	streamOptions.ExcludeRequestBody = true
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Validate the path without the file extension the URLFormat middleware parsed, e.g. /tax-years/2022.csv.
+			req := r
+			if format, _ := r.Context().Value(chimiddleware2.URLFormatCtxKey).(string); format != "" {
+				req = r.Clone(r.Context())
+				req.URL.Path = strings.TrimSuffix(r.URL.Path, "."+format)
+				req.URL.RawPath = ""
+			}
+			route, pathParams, err := specRouter.FindRoute(req)
+			if err != nil {
+				WriteProblem(w, r, &Err{
+					Code:    http.StatusNotFound,
+					Message: fmt.Sprintf("the path %v is not found", r.URL.Path),
+				})
+				return
+			}
+			input := &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if streamedCalculationJob(r) {
+				input.Options = &streamOptions
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				writeProblem(w, newValidationProblem(r.Context(), err))
+				return
+			}
+			// The validation reads the body and replaces it with a copy.
+			r.Body = req.Body
+			next.ServeHTTP(w, r)
+		})
 	}, nil
 }
 
@@ -279,14 +791,20 @@ func NewSecurityMiddleware() func(http.Handler) http.Handler {
 
 			apiKey := r.Header.Get("X-Api-Key")
 			if apiKey == "" {
-				w.WriteHeader(http.StatusUnauthorized)
-				w.Write([]byte("header X-Api-Key not provided"))
+				WriteProblem(w, r, &Err{
+					Code:    http.StatusUnauthorized,
+					Field:   "X-Api-Key",
+					Message: "header X-Api-Key not provided",
+				})
 				return
 			}
 
 			if apiKey != "test" {
-				w.WriteHeader(http.StatusUnauthorized)
-				w.Write([]byte("invalid api key provided"))
+				WriteProblem(w, r, &Err{
+					Code:    http.StatusUnauthorized,
+					Field:   "X-Api-Key",
+					Message: "invalid api key provided",
+				})
 				return
 			}
 
@@ -307,16 +825,52 @@ type Err struct {
 // TaxOwed represents the tax owed for a given year.
 type TaxOwed struct {
 	EffectiveTaxRate string       `json:"effective_tax_rate"`
-	Salary           float32      `json:"salary"`
+	Salary           float64      `json:"salary"`
 	TaxYear          string       `json:"tax_year"`
-	TaxOwnedPerBand  []TaxBracket `json:"tax_owned_per_band"`
-	TotalTaxOwed     float32      `json:"total_tax_owed"`
+	TaxOwedPerBand   []TaxBracket `json:"tax_owed_per_band"`
+	TotalTaxOwed     float64      `json:"total_tax_owed"`
+	// EffectiveRate is the effective tax rate as a fraction, and MarginalTaxRate the combined rate of the next dollar.
+	EffectiveRate   float64 `json:"effective_rate"`
+	MarginalTaxRate float64 `json:"marginal_tax_rate"`
+
+	Jurisdiction      string  `json:"jurisdiction"`
+	FilingStatus      string  `json:"filing_status,omitempty"`
+	StandardDeduction float64 `json:"standard_deduction,omitempty"`
+	TaxableIncome     float64 `json:"taxable_income"`
+
+	Province                 string       `json:"province,omitempty"`
+	Deductions               float64      `json:"deductions,omitempty"`
+	FederalTaxOwed           float64      `json:"federal_tax_owed"`
+	ProvincialTaxOwed        float64      `json:"provincial_tax_owed,omitempty"`
+	ProvincialTaxOwedPerBand []TaxBracket `json:"provincial_tax_owed_per_band,omitempty"`
+	NetIncome                float64      `json:"net_income"`
+
+	TotalIncome float64      `json:"total_income,omitempty"`
+	IncomeItems []IncomeItem `json:"income_items,omitempty"`
+
+	TaxWithheld     float64 `json:"tax_withheld,omitempty"`
+	InstalmentsPaid float64 `json:"instalments_paid,omitempty"`
+	Refund          float64 `json:"refund"`
+	BalanceOwing    float64 `json:"balance_owing"`
+
+	Residency *Residency     `json:"residency,omitempty"`
+	Rounding  RoundingPolicy `json:"rounding"`
+
+	Provenance     *Provenance `json:"provenance,omitempty"`
+	DatasetVersion string      `json:"dataset_version"`
+
+	Credits       []Adjustment `json:"credits,omitempty"`
+	Surtaxes      []Adjustment `json:"surtaxes,omitempty"`
+	Clawbacks     []Adjustment `json:"clawbacks,omitempty"`
+	Contributions []Adjustment `json:"contributions,omitempty"`
+	// TotalContributions is the sum of the payroll contributions.
+	TotalContributions float64 `json:"total_contributions,omitempty"`
 }
 
 // TaxBracket returns the tax bracket for a given year.
 func GetTaxCalculatorInstructionsByYear(year string) ([]TaxBracket, *Err) {
 	if year == "" {
-		year = "2022"
+		year = LatestTaxYear()
 	}
 	taxBrackets := TaxBrackets[year]
 	if len(taxBrackets) == 0 {
@@ -341,8 +895,131 @@ func ValidateYear(year string) *Err {
 	return nil
 }
 
+// GetUSTaxCalculatorInstructionsByYear returns the US federal tax brackets for a given year and filing status.
+func GetUSTaxCalculatorInstructionsByYear(year string, filingStatus string) (FilingStatusBrackets, *Err) {
+	filingStatusBrackets, ok := USFederalTaxBrackets[year][filingStatus]
+	if !ok {
+		return FilingStatusBrackets{}, &Err{
+			Code:    http.StatusNotFound,
+			Field:   "year",
+			Message: fmt.Sprintf("US tax brackets for the tax year '%v' and filing status '%v' is not found", year, filingStatus),
+		}
+	}
+	return filingStatusBrackets, nil
+}
+
+// ValidateJurisdiction validates the jurisdiction for the tax calculation.
+func ValidateJurisdiction(jurisdiction string) *Err {
+	switch jurisdiction {
+	case JurisdictionCanada, JurisdictionUS:
+		return nil
+	}
+	return &Err{
+		Code:    http.StatusBadRequest,
+		Field:   "jurisdiction",
+		Message: fmt.Sprintf("the jurisdiction %v is not supported", jurisdiction),
+	}
+}
+
+// ValidateFilingStatus validates the filing status for the jurisdiction.
+// A filing status is required by the US jurisdiction and not accepted by any other.
+func ValidateFilingStatus(jurisdiction string, filingStatus string) *Err {
+	if jurisdiction != JurisdictionUS {
+		if filingStatus != "" {
+			return &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "filing_status",
+				Message: fmt.Sprintf("the filing status is not supported for the jurisdiction %v", jurisdiction),
+			}
+		}
+		return nil
+	}
+	switch filingStatus {
+	case FilingStatusSingle, FilingStatusMarriedFilingJointly, FilingStatusMarriedFilingSeparately, FilingStatusHeadOfHousehold:
+		return nil
+	}
+	return &Err{
+		Code:    http.StatusBadRequest,
+		Field:   "filing_status",
+		Message: fmt.Sprintf("the filing status %v is not a valid filing status", filingStatus),
+	}
+}
+
+// GetProvincialTaxCalculatorInstructionsByYear returns the provincial tax brackets for a given province and year.
+func GetProvincialTaxCalculatorInstructionsByYear(province string, year string) ([]TaxBracket, *Err) {
+	taxBrackets := ProvincialTaxBrackets[province][year]
+	if len(taxBrackets) == 0 {
+		return nil, &Err{
+			Code:    http.StatusNotFound,
+			Field:   "year",
+			Message: fmt.Sprintf("tax brackets for the province '%v' and tax year '%v' is not found", province, year),
+		}
+	}
+	return taxBrackets, nil
+}
+
+// ProvincialTaxAuthority returns the authority the provincial return of a province is filed with.
+func ProvincialTaxAuthority(province string) string {
+	if authority, ok := ProvincialTaxAuthorities[province]; ok {
+		return authority
+	}
+	return "Canada Revenue Agency"
+}
+
+// ProvincialJurisdiction returns the jurisdiction of a Canadian province, e.g. CA-ON.
+func ProvincialJurisdiction(province string) string {
+	return JurisdictionCanada + "-" + province
+}
+
+// ValidateProvince validates the province for the jurisdiction.
+// A province is optional for the CA jurisdiction and not accepted by any other.
+func ValidateProvince(jurisdiction string, province string) *Err {
+	if province == "" {
+		return nil
+	}
+	if jurisdiction != JurisdictionCanada {
+		return &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "province",
+			Message: fmt.Sprintf("the province is not supported for the jurisdiction %v", jurisdiction),
+		}
+	}
+	if _, ok := ProvincialTaxBrackets[province]; !ok {
+		return &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "province",
+			Message: fmt.Sprintf("the province %v is not a supported province", province),
+		}
+	}
+	return nil
+}
+
+// ValidateDeductions validates the deductions against the total income.
+func ValidateDeductions(income float64, deductions float64) *Err {
+	if deductions < 0 || deductions > income {
+		return &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "deductions",
+			Message: fmt.Sprintf("the deductions must be between 0 and the total income. Invalid value: %.2f", deductions),
+		}
+	}
+	return nil
+}
+
+// ValidatePayment validates a tax payment, e.g. the tax withheld at source or the instalments paid.
+func ValidatePayment(field string, amount float64) *Err {
+	if amount < 0 {
+		return &Err{
+			Code:    http.StatusBadRequest,
+			Field:   field,
+			Message: fmt.Sprintf("the %v must be greater than 0. Invalid value: %.2f", field, amount),
+		}
+	}
+	return nil
+}
+
 // ValidateSalary validates the salary for the tax year.
-func ValidateSalary(salary float32) *Err {
+func ValidateSalary(salary float64) *Err {
 	if salary < 0 {
 		return &Err{
 			Code:    http.StatusBadRequest,
@@ -354,30 +1031,44 @@ func ValidateSalary(salary float32) *Err {
 }
 
 // CalculateTaxAmount calculates the tax owed for a given year based on the tax brackets and salary.
-func CalculateTaxAmount(year string, taxBrackets []TaxBracket, salary float32) TaxOwed {
-	var taxAmount float32
-	var taxPerBracket []TaxBracket
-	totalTaxAmount := float32(0.0)
-	for _, bracket := range taxBrackets {
-		if salary > bracket.Min {
-			leftover := salary
-			if bracket.Max > 0 && salary > bracket.Max {
-				leftover = float32(math.Min(float64(salary), float64(bracket.Max)))
-			}
-			taxableIncome := leftover - bracket.Min
-			taxAmount = taxableIncome * bracket.Rate
-			totalTaxAmount += taxAmount
-			bracket.TaxOwed = float32(math.Round(float64(taxAmount*100)) / 100)
+func CalculateTaxAmount(year string, taxBrackets []TaxBracket, salary float64) TaxOwed {
+	calc := NewCalculationContext(year, JurisdictionCanada, salary, taxBrackets)
+	BracketTaxRule{}.Apply(calc)
+	return calc.TaxOwed()
+}
 
-			taxPerBracket = append(taxPerBracket, bracket)
+// GetTaxRulePipeline returns the ordered rules of the calculation for a given jurisdiction and year.
+func GetTaxRulePipeline(jurisdiction string, year string) ([]TaxRule, *Err) {
+	rules := TaxRulePipelines[jurisdiction][year]
+	if len(rules) == 0 {
+		return nil, &Err{
+			Code:    http.StatusNotFound,
+			Field:   "year",
+			Message: fmt.Sprintf("tax rules for the jurisdiction '%v' and tax year '%v' is not found", jurisdiction, year),
 		}
 	}
-	effectiveRate := totalTaxAmount / salary
-	return TaxOwed{
-		EffectiveTaxRate: fmt.Sprintf("%.2f", effectiveRate*100) + "%",
-		Salary:           salary,
-		TaxYear:          year,
-		TaxOwnedPerBand:  taxPerBracket,
-		TotalTaxOwed:     float32(math.Round(float64(totalTaxAmount)*100) / 100),
+	return rules, nil
+}
+
+// CalculateTax runs the rule pipeline of the calculation's jurisdiction and year, followed by the
+// pipeline of its province if any, and returns the tax owed.
+func CalculateTax(calc *CalculationContext) (TaxOwed, *Err) {
+	rules, err := GetTaxRulePipeline(calc.Jurisdiction, calc.Year)
+	if err != nil {
+		return TaxOwed{}, err
+	}
+	if err := RunTaxRules(calc, rules); err != nil {
+		return TaxOwed{}, err
+	}
+	if calc.Province != "" {
+		rules, err := GetTaxRulePipeline(calc.Provincial.Jurisdiction, calc.Year)
+		if err != nil {
+			return TaxOwed{}, err
+		}
+		calc.Level = &calc.Provincial
+		if err := RunTaxRules(calc, rules); err != nil {
+			return TaxOwed{}, err
+		}
 	}
+	return calc.TaxOwed(), nil
 }
@@ -3,7 +3,12 @@ package main
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"patrickyau/interview-test-server/api"
+	"strings"
 	"testing"
+	"time"
 )
 
 // TestGetAllTaxCalculatorInstructions tests the GetAllTaxCalculatorInstructions function.
@@ -11,14 +16,24 @@ func TestGetAllTaxCalculatorInstructions(t *testing.T) {
 	// TODO: Implement this test
 }
 
+// TestCalculateTaxAmount tests the CalculateTaxAmount function against the golden files.
 func TestCalculateTaxAmount(t *testing.T) {
-	// TODO: Implement this test
+	cases := readGoldenCases(t)
+	for _, year := range cases.Years {
+		t.Run(year, func(t *testing.T) {
+			var results []TaxOwed
+			for _, salary := range cases.Salaries {
+				results = append(results, CalculateTaxAmount(year, TaxBrackets[year], salary))
+			}
+			assertGolden(t, filepath.Join("calculate_tax_amount", year), results)
+		})
+	}
 }
 
 // TestValidateSalary tests the ValidateSalary function.
 func TestValidateSalary(t *testing.T) {
 	var tests = []struct {
-		salary float32
+		salary float64
 		valid  bool
 	}{
 		{50000, true},
@@ -82,11 +97,61 @@ func TestGetTaxCalculatorInstructionsByYear(t *testing.T) {
 		},
 		{
 			"2018",
+			[]TaxBracket{
+				{
+					Min:  0,
+					Max:  46605,
+					Rate: 0.15,
+				},
+				{
+					Min:  46605,
+					Max:  93208,
+					Rate: 0.205,
+				},
+				{
+					Min:  93208,
+					Max:  144489,
+					Rate: 0.26,
+				},
+				{
+					Min:  144489,
+					Max:  205842,
+					Rate: 0.29,
+				},
+				{
+					Min:  205842,
+					Rate: 0.33,
+				},
+			},
+			nil,
+		},
+		{
+			"2000",
+			[]TaxBracket{
+				{
+					Min:  0,
+					Max:  30004,
+					Rate: 0.17,
+				},
+				{
+					Min:  30004,
+					Max:  60009,
+					Rate: 0.25,
+				},
+				{
+					Min:  60009,
+					Rate: 0.29,
+				},
+			},
+			nil,
+		},
+		{
+			"1999",
 			[]TaxBracket{},
 			&Err{
 				Code:    http.StatusNotFound,
 				Field:   "year",
-				Message: "tax brackets for the tax year 2018 is not found",
+				Message: "tax brackets for the tax year '1999' is not found",
 			},
 		},
 	}
@@ -108,3 +173,316 @@ func TestGetTaxCalculatorInstructionsByYear(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateFilingStatus tests the ValidateFilingStatus function.
+func TestValidateFilingStatus(t *testing.T) {
+	var tests = []struct {
+		jurisdiction string
+		filingStatus string
+		valid        bool
+	}{
+		{JurisdictionUS, FilingStatusSingle, true},
+		{JurisdictionUS, FilingStatusMarriedFilingJointly, true},
+		{JurisdictionUS, FilingStatusMarriedFilingSeparately, true},
+		{JurisdictionUS, FilingStatusHeadOfHousehold, true},
+		{JurisdictionUS, "", false},
+		{JurisdictionUS, "widowed", false},
+		{JurisdictionCanada, "", true},
+		{JurisdictionCanada, FilingStatusSingle, false},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v/%v", tt.jurisdiction, tt.filingStatus)
+		t.Run(testname, func(t *testing.T) {
+			err := ValidateFilingStatus(tt.jurisdiction, tt.filingStatus)
+			if err != nil && tt.valid {
+				t.Errorf("got %v, want %v", false, tt.valid)
+			} else if err == nil && !tt.valid {
+				t.Errorf("got %v, want %v", true, tt.valid)
+			}
+		})
+	}
+}
+
+// TestCalculateUSTax tests the US federal calculation pipeline.
+func TestCalculateUSTax(t *testing.T) {
+	var tests = []struct {
+		year          string
+		filingStatus  string
+		salary        float64
+		taxableIncome float64
+		want          float64
+	}{
+		{"2023", FilingStatusSingle, 10000, 0, 0},
+		{"2023", FilingStatusSingle, 100000, 86150, 14261},
+		{"2023", FilingStatusMarriedFilingJointly, 100000, 72300, 8236},
+		{"2022", FilingStatusHeadOfHousehold, 60000, 40600, 4579},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v/%v/%.2f", tt.year, tt.filingStatus, tt.salary)
+		t.Run(testname, func(t *testing.T) {
+			filingStatusBrackets, err := GetUSTaxCalculatorInstructionsByYear(tt.year, tt.filingStatus)
+			if err != nil {
+				t.Fatalf("got error %v, want nil", *err)
+			}
+			calc := NewCalculationContext(tt.year, JurisdictionUS, tt.salary, filingStatusBrackets.Brackets)
+			calc.FilingStatus = tt.filingStatus
+			calc.StandardDeduction = filingStatusBrackets.StandardDeduction
+			ans, err := CalculateTax(calc)
+			if err != nil {
+				t.Fatalf("got error %v, want nil", *err)
+			}
+			if ans.TaxableIncome != tt.taxableIncome {
+				t.Errorf("got taxable income %v, want %v", ans.TaxableIncome, tt.taxableIncome)
+			}
+			if ans.TotalTaxOwed != tt.want {
+				t.Errorf("got total tax owed %v, want %v", ans.TotalTaxOwed, tt.want)
+			}
+		})
+	}
+}
+
+// TestCalculateProvincialTax tests that the provincial tax is added to the federal tax, less the basic personal amounts
+// and with the Ontario surtax.
+func TestCalculateProvincialTax(t *testing.T) {
+	s := NewTaxService()
+	taxOwed, err := s.calculate("2023", api.CalculateRequest{Salary: 100000, Province: ProvinceOntario})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	if taxOwed.FederalTaxOwed != 15315.26 {
+		t.Errorf("got federal tax owed %v, want %v", taxOwed.FederalTaxOwed, 15315.26)
+	}
+	if taxOwed.ProvincialTaxOwed != 6812.89 {
+		t.Errorf("got provincial tax owed %v, want %v", taxOwed.ProvincialTaxOwed, 6812.89)
+	}
+	if taxOwed.TotalTaxOwed != 22128.14 {
+		t.Errorf("got total tax owed %v, want %v", taxOwed.TotalTaxOwed, 22128.14)
+	}
+	if taxOwed.NetIncome != 73114.96 {
+		t.Errorf("got net income %v, want %v", taxOwed.NetIncome, 73114.96)
+	}
+	wantContributions := []Adjustment{{Name: "cpp", Amount: 3754.45}, {Name: "ei", Amount: 1002.45}}
+	if fmt.Sprint(taxOwed.Contributions) != fmt.Sprint(wantContributions) {
+		t.Errorf("got contributions %v, want %v", taxOwed.Contributions, wantContributions)
+	}
+	// The Ontario surtax is 20% of the Ontario tax after the credits above 5315; it is under the 36% threshold of 6802.
+	wantSurtaxes := []Adjustment{{Name: "ontario_surtax", Amount: 249.65}}
+	if fmt.Sprint(taxOwed.Surtaxes) != fmt.Sprint(wantSurtaxes) {
+		t.Errorf("got surtaxes %v, want %v", taxOwed.Surtaxes, wantSurtaxes)
+	}
+}
+
+// TestCalculateQuebecTax tests the federal abatement and the Quebec payroll contributions.
+func TestCalculateQuebecTax(t *testing.T) {
+	s := NewTaxService()
+	taxOwed, err := s.calculate("2023", api.CalculateRequest{Salary: 100000, Province: ProvinceQuebec})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	wantCredits := []Adjustment{{Name: "federal_basic_personal_amount", Amount: 2250}, {Name: "quebec_abatement", Amount: 2527.02}, {Name: "provincial_basic_personal_amount", Amount: 2405.62}}
+	if fmt.Sprint(taxOwed.Credits) != fmt.Sprint(wantCredits) {
+		t.Errorf("got credits %v, want %v", taxOwed.Credits, wantCredits)
+	}
+	if taxOwed.FederalTaxOwed != 12788.24 {
+		t.Errorf("got federal tax owed %v, want %v", taxOwed.FederalTaxOwed, 12788.24)
+	}
+	if taxOwed.ProvincialTaxOwed != 14203.63 {
+		t.Errorf("got provincial tax owed %v, want %v", taxOwed.ProvincialTaxOwed, 14203.63)
+	}
+	wantContributions := []Adjustment{{Name: "qpp", Amount: 4038.4}, {Name: "qpip", Amount: 449.54}, {Name: "ei", Amount: 781.05}}
+	if fmt.Sprint(taxOwed.Contributions) != fmt.Sprint(wantContributions) {
+		t.Errorf("got contributions %v, want %v", taxOwed.Contributions, wantContributions)
+	}
+}
+
+// TestValidateProvince tests the ValidateProvince function.
+func TestValidateProvince(t *testing.T) {
+	var tests = []struct {
+		jurisdiction string
+		province     string
+		valid        bool
+	}{
+		{JurisdictionCanada, "", true},
+		{JurisdictionCanada, ProvinceOntario, true},
+		{JurisdictionCanada, ProvinceQuebec, true},
+		{JurisdictionCanada, "YT", false},
+		{JurisdictionUS, ProvinceOntario, false},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v/%v", tt.jurisdiction, tt.province)
+		t.Run(testname, func(t *testing.T) {
+			err := ValidateProvince(tt.jurisdiction, tt.province)
+			if err != nil && tt.valid {
+				t.Errorf("got %v, want %v", false, tt.valid)
+			} else if err == nil && !tt.valid {
+				t.Errorf("got %v, want %v", true, tt.valid)
+			}
+		})
+	}
+}
+
+// TestCalculateRefundOrBalanceOwing tests the settlement of the tax already paid against the total tax owed.
+func TestCalculateRefundOrBalanceOwing(t *testing.T) {
+	var tests = []struct {
+		taxWithheld     float64
+		instalmentsPaid float64
+		refund          float64
+		balanceOwing    float64
+	}{
+		{0, 0, 0, 17739.17},
+		{18000, 0, 260.83, 0},
+		{15000, 2000, 0, 739.17},
+		{15000, 2739.17, 0, 0},
+	}
+	s := NewTaxService()
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%.2f/%.2f", tt.taxWithheld, tt.instalmentsPaid)
+		t.Run(testname, func(t *testing.T) {
+			taxOwed, err := s.calculate("2022", api.CalculateRequest{
+				Salary:          100000,
+				TaxWithheld:     tt.taxWithheld,
+				InstalmentsPaid: tt.instalmentsPaid,
+			})
+			if err != nil {
+				t.Fatalf("got error %v, want nil", *err)
+			}
+			if taxOwed.Refund != tt.refund {
+				t.Errorf("got refund %v, want %v", taxOwed.Refund, tt.refund)
+			}
+			if taxOwed.BalanceOwing != tt.balanceOwing {
+				t.Errorf("got balance owing %v, want %v", taxOwed.BalanceOwing, tt.balanceOwing)
+			}
+			// Both amounts are returned, even when they are 0, once tax was paid.
+			response := mapTaxOwedToAPICalculateResponse(taxOwed)
+			if paid := tt.taxWithheld > 0 || tt.instalmentsPaid > 0; paid != (response.Refund != nil) || paid != (response.BalanceOwing != nil) {
+				t.Errorf("got refund %v and balance owing %v, want them returned: %v", response.Refund, response.BalanceOwing, paid)
+			}
+		})
+	}
+}
+
+// TestCalculateFederalTax tests that the calculations without a province are bracket-only in every year, and that the
+// calculations with a province claim the prorated federal basic personal amount.
+func TestCalculateFederalTax(t *testing.T) {
+	s := NewTaxService()
+	for _, year := range []string{"2018", "2019", "2023"} {
+		taxOwed, err := s.calculate(year, api.CalculateRequest{Salary: 100000})
+		if err != nil {
+			t.Fatalf("got error %v, want nil", *err)
+		}
+		if want := CalculateTaxAmount(year, TaxBrackets[year], 100000); taxOwed.FederalTaxOwed != want.TotalTaxOwed || len(taxOwed.Credits) != 0 {
+			t.Errorf("%v: got federal tax %v and credits %v without a province, want the bracket tax %v", year, taxOwed.FederalTaxOwed, taxOwed.Credits, want.TotalTaxOwed)
+		}
+	}
+
+	var tests = []struct {
+		input  api.CalculateRequest
+		credit float64
+	}{
+		// 15000 x 15%
+		{api.CalculateRequest{Salary: 100000, Province: ProvinceOntario}, 2250},
+		// Prorated by the 184 days of residency of 365.
+		{api.CalculateRequest{Salary: 50000, Province: ProvinceOntario, ResidencyStartDate: date("2023-07-01")}, 1134.25},
+	}
+	for _, tt := range tests {
+		taxOwed, err := s.calculate("2023", tt.input)
+		if err != nil {
+			t.Fatalf("got error %v, want nil", *err)
+		}
+		bracketTax := CalculateTaxAmount("2023", TaxBrackets["2023"], taxOwed.TaxableIncome).TotalTaxOwed
+		if got := taxOwed.Credits[0]; got.Name != "federal_basic_personal_amount" || got.Amount != tt.credit {
+			t.Errorf("got credit %v, want the federal basic personal amount of %v", got, tt.credit)
+		}
+		if want := roundCents(bracketTax - tt.credit); taxOwed.FederalTaxOwed != want {
+			t.Errorf("got federal tax %v, want %v", taxOwed.FederalTaxOwed, want)
+		}
+	}
+}
+
+// TestGetCalculation tests that a calculation from query parameters matches the calculation from the same JSON body,
+// with the cache headers.
+func TestGetCalculation(t *testing.T) {
+	s := NewTaxService()
+	s.Clock = func() time.Time { return time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC) }
+	router, err := NewRouter(s)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		name         string
+		year         string
+		query        string
+		body         string
+		cacheControl string
+	}{
+		{"salary", "2022", "salary=100000", `{"salary": 100000}`, "public, max-age=86400"},
+		{"province", "2023", "salary=100000&province=ON&tax_withheld=20000", `{"salary": 100000, "province": "ON", "tax_withheld": 20000}`, "public, max-age=86400"},
+		{"US", "2023", "salary=100000&jurisdiction=US&filing_status=single", `{"salary": 100000, "jurisdiction": "US", "filing_status": "single"}`, "public, max-age=86400"},
+		{"non-resident", "2023", "salary=100000&non_resident=true&canadian_source_income=40000", `{"salary": 100000, "non_resident": true, "canadian_source_income": 40000}`, "public, max-age=86400"},
+		{"immigrant", "2023", "salary=50000&residency_start_date=2023-07-01", `{"salary": 50000, "residency_start_date": "2023-07-01"}`, "public, max-age=86400"},
+		{"symbolic year", "current", "salary=50000", `{"salary": 50000}`, "public, max-age=3600"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tax-calculator/tax-years/"+tt.year+"/calculate", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			want := httptest.NewRecorder()
+			router.ServeHTTP(want, req)
+			if want.Code != http.StatusOK {
+				t.Fatalf("got status %v for the POST request, want %v: %v", want.Code, http.StatusOK, want.Body.String())
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tax-calculator/tax-years/"+tt.year+"/calculate?"+tt.query, nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			if rec.Body.String() != want.Body.String() {
+				t.Errorf("got\n%v\nwant\n%v", rec.Body.String(), want.Body.String())
+			}
+			for header, value := range map[string]string{
+				"Cache-Control":     tt.cacheControl,
+				"Vary":              "Accept",
+				"X-Dataset-Version": DatasetVersion,
+				"X-Tax-Year":        want.Header().Get("X-Tax-Year"),
+			} {
+				if got := rec.Header().Get(header); got != value {
+					t.Errorf("got %v %v, want %v", header, got, value)
+				}
+			}
+		})
+	}
+}
+
+// TestGetCalculationInvalid tests that invalid query parameters are rejected without cache headers.
+func TestGetCalculationInvalid(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		path string
+		want int
+	}{
+		{"/tax-calculator/tax-years/2022/calculate", http.StatusBadRequest},
+		{"/tax-calculator/tax-years/2022/calculate?salary=abc", http.StatusBadRequest},
+		{"/tax-calculator/tax-years/2022/calculate?salary=-1", http.StatusBadRequest},
+		{"/tax-calculator/tax-years/2022/calculate?salary=1&province=XX", http.StatusBadRequest},
+		{"/tax-calculator/tax-years/1999/calculate?salary=1", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.want {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, tt.want, rec.Body.String())
+			}
+			if contentType := rec.Header().Get("Content-Type"); contentType != ContentTypeProblemJSON {
+				t.Errorf("got content type %v, want %v", contentType, ContentTypeProblemJSON)
+			}
+			if cacheControl := rec.Header().Get("Cache-Control"); cacheControl != "" {
+				t.Errorf("got Cache-Control %v, want none", cacheControl)
+			}
+		})
+	}
+}
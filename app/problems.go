@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	chimiddleware2 "github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/http/httpguts"
+)
+
+// ContentTypeProblemJSON is the media type of the problem details of RFC 7807.
+const ContentTypeProblemJSON = "application/problem+json"
+
+// Problem types, resolved against the URL of the request.
+const (
+	ProblemTypeInvalidRequest   = "/tax-calculator/problems/invalid-request"
+	ProblemTypeNotFound         = "/tax-calculator/problems/not-found"
+	ProblemTypeMethodNotAllowed = "/tax-calculator/problems/method-not-allowed"
+	ProblemTypeUnauthorized     = "/tax-calculator/problems/unauthorized"
+	ProblemTypeInternalError    = "/tax-calculator/problems/internal-error"
+	ProblemTypeConflict         = "/tax-calculator/problems/conflict"
+	ProblemTypeUnavailable      = "/tax-calculator/problems/unavailable"
+	ProblemTypeTimeout          = "/tax-calculator/problems/timeout"
+	ProblemTypeTooLarge         = "/tax-calculator/problems/request-too-large"
+)
+
+// problemTypes represents the problem type of every status code the service responds with.
+var problemTypes = map[int]string{
+	http.StatusBadRequest:            ProblemTypeInvalidRequest,
+	http.StatusNotFound:              ProblemTypeNotFound,
+	http.StatusMethodNotAllowed:      ProblemTypeMethodNotAllowed,
+	http.StatusUnauthorized:          ProblemTypeUnauthorized,
+	http.StatusInternalServerError:   ProblemTypeInternalError,
+	http.StatusConflict:              ProblemTypeConflict,
+	http.StatusServiceUnavailable:    ProblemTypeUnavailable,
+	http.StatusGatewayTimeout:        ProblemTypeTimeout,
+	http.StatusRequestEntityTooLarge: ProblemTypeTooLarge,
+}
+
+// NewProblem returns the problem details of the error, with the ID of the request of the context. The field of the
+// error, if any, is reported as the invalid parameter.
+func NewProblem(ctx context.Context, err *Err) api.ErrorResponses {
+	problemType, ok := problemTypes[err.Code]
+	if !ok {
+		problemType = "about:blank"
+	}
+	problem := api.ErrorResponses{
+		Type:      problemType,
+		Title:     http.StatusText(err.Code),
+		Status:    err.Code,
+		Detail:    err.Message,
+		RequestId: chimiddleware2.GetReqID(ctx),
+		Code:      err.Code,
+		Field:     err.Field,
+		Message:   err.Message,
+	}
+	if err.Field != "" {
+		problem.InvalidParams = []api.InvalidParam{{Name: err.Field, Reason: err.Message}}
+	}
+	return problem
+}
+
+// WriteProblem writes the problem details of the error as application/problem+json.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err *Err) {
+	writeProblem(w, NewProblem(r.Context(), err))
+}
+
+// writeProblem writes the problem details as application/problem+json.
+func writeProblem(w http.ResponseWriter, problem api.ErrorResponses) {
+	w.Header().Set("Content-Type", ContentTypeProblemJSON)
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+// NewTimeoutMiddleware returns a middleware cancelling the context of the requests after the timeout. A request whose
+// handler returns without a response once the timeout is reached is answered with a 504 problem.
+func NewTimeoutMiddleware(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			ww := chimiddleware2.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && ww.Status() == 0 {
+				WriteProblem(w, r, &Err{
+					Code:    http.StatusGatewayTimeout,
+					Message: fmt.Sprintf("the request did not complete within %v", timeout),
+				})
+			}
+		})
+	}
+}
+
+// NewRecovererMiddleware returns a middleware recovering from the panics of the handlers, which are logged with their
+// stack and answered with a 500 problem. The WebSocket upgrades are not answered, as their connection is hijacked.
+func NewRecovererMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if recovered == http.ErrAbortHandler {
+					// The handler aborted the response on purpose, which the server handles.
+					panic(recovered)
+				}
+				log.Error().Str("request_id", chimiddleware2.GetReqID(r.Context())).Bytes("stack", debug.Stack()).Msgf("panic: %v", recovered)
+				if !httpguts.HeaderValuesContainsToken(r.Header["Connection"], "upgrade") {
+					WriteProblem(w, r, &Err{
+						Code:    http.StatusInternalServerError,
+						Message: "the request could not be processed",
+					})
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// NewBodyLimitMiddleware returns a middleware limiting the size of the request bodies, which are read in memory. The
+// streamed calculation jobs are limited by their handler instead.
+func NewBodyLimitMiddleware(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !streamedCalculationJob(r) {
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bodyError returns the error of a request body that could not be read or decoded, which is too large when it goes
+// over the limit of the body limit middleware.
+func bodyError(err error) *Err {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &Err{
+			Code:    http.StatusRequestEntityTooLarge,
+			Field:   "body",
+			Message: fmt.Sprintf("the request body is larger than %d bytes", maxBytesErr.Limit),
+		}
+	}
+	return &Err{
+		Code:    http.StatusBadRequest,
+		Field:   "body",
+		Message: err.Error(),
+	}
+}
+
+// paramError returns the error of a request parameter that could not be bound to its operation.
+func paramError(err error) *Err {
+	var paramName string
+	switch e := err.(type) {
+	case *api.InvalidParamFormatError:
+		paramName = e.ParamName
+	case *api.RequiredParamError:
+		paramName = e.ParamName
+	case *api.RequiredHeaderError:
+		paramName = e.ParamName
+	case *api.UnmarshalingParamError:
+		paramName = e.ParamName
+	case *api.TooManyValuesForParamError:
+		paramName = e.ParamName
+	case *api.UnescapedCookieParamError:
+		paramName = e.ParamName
+	}
+	return &Err{
+		Code:    http.StatusBadRequest,
+		Field:   paramName,
+		Message: err.Error(),
+	}
+}
+
+// newValidationProblem returns the problem details of a request that does not match the OpenAPI spec, with every
+// invalid parameter and body field.
+func newValidationProblem(ctx context.Context, err error) api.ErrorResponses {
+	var securityErr *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &securityErr) {
+		return NewProblem(ctx, &Err{
+			Code:    http.StatusUnauthorized,
+			Message: firstLine(err.Error()),
+		})
+	}
+	if bodyErr := bodyError(err); bodyErr.Code == http.StatusRequestEntityTooLarge {
+		return NewProblem(ctx, bodyErr)
+	}
+	params := invalidParams("", err)
+	if len(params) == 0 {
+		params = []api.InvalidParam{{Name: paramOrBody(""), Reason: firstLine(err.Error())}}
+	}
+	problem := NewProblem(ctx, &Err{
+		Code:    http.StatusBadRequest,
+		Field:   params[0].Name,
+		Message: firstLine(err.Error()),
+	})
+	problem.InvalidParams = params
+	return problem
+}
+
+// invalidParams returns the invalid parameters and body fields of a request validation error. The name is the name
+// of the parameter the error is about, or empty for the request body.
+func invalidParams(name string, err error) []api.InvalidParam {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var params []api.InvalidParam
+		for _, err := range e {
+			params = append(params, invalidParams(name, err)...)
+		}
+		return params
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			name = e.Parameter.Name
+		}
+		if e.Err != nil {
+			return invalidParams(name, e.Err)
+		}
+		return []api.InvalidParam{{Name: paramOrBody(name), Reason: e.Reason}}
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); name == "" && len(pointer) > 0 {
+			name = fieldPath(pointer)
+		}
+		return []api.InvalidParam{{Name: paramOrBody(name), Reason: e.Reason}}
+	}
+	return []api.InvalidParam{{Name: paramOrBody(name), Reason: firstLine(err.Error())}}
+}
+
+// paramOrBody returns the name of the parameter, or body for the request body.
+func paramOrBody(name string) string {
+	if name == "" {
+		return "body"
+	}
+	return name
+}
+
+// fieldPath formats the JSON pointer of a body field like the fields of the errors, e.g. income_items[0].currency.
+func fieldPath(pointer []string) string {
+	var path strings.Builder
+	for _, token := range pointer {
+		if _, err := strconv.Atoi(token); err == nil {
+			path.WriteString("[" + token + "]")
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(token)
+	}
+	return path.String()
+}
+
+// firstLine returns the first line of the message, as the validation errors continue with the schema.
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	return line
+}
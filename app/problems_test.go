@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"patrickyau/interview-test-server/api"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestProblems tests that every failure path responds with the problem details of RFC 7807.
+func TestProblems(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		name          string
+		method        string
+		path          string
+		body          string
+		status        int
+		problemType   string
+		invalidParams []string
+	}{
+		{"handler", http.MethodGet, "/tax-calculator/tax-years/1999", "", http.StatusNotFound, ProblemTypeNotFound, []string{"year"}},
+		{"handler validation", http.MethodPost, "/tax-calculator/tax-years/2022/calculate", `{"salary": -1}`, http.StatusBadRequest, ProblemTypeInvalidRequest, []string{"salary"}},
+		{"validator", http.MethodPost, "/tax-calculator/tax-years/2022/calculate", `{"salary": "abc", "income_items": [{"description": "x", "amount": "1", "currency": "USD"}]}`, http.StatusBadRequest, ProblemTypeInvalidRequest, []string{"income_items[0].amount", "salary"}},
+		{"malformed body", http.MethodPost, "/tax-calculator/tax-years/2022/calculate", `{"salary": 1`, http.StatusBadRequest, ProblemTypeInvalidRequest, []string{"body"}},
+		{"unknown path", http.MethodGet, "/tax-calculator/unknown", "", http.StatusNotFound, ProblemTypeNotFound, nil},
+		{"method not allowed", http.MethodDelete, "/tax-calculator/tax-years/2022", "", http.StatusMethodNotAllowed, ProblemTypeMethodNotAllowed, nil},
+		{"v2 handler", http.MethodPost, "/tax-calculator/v2/tax-years/1999/calculate", `{"salary": 1}`, http.StatusNotFound, ProblemTypeNotFound, []string{"year"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			problem := readProblem(t, rec)
+			if rec.Code != tt.status || problem.Status != tt.status {
+				t.Errorf("got status %v and problem status %v, want %v", rec.Code, problem.Status, tt.status)
+			}
+			if problem.Type != tt.problemType || problem.Title != http.StatusText(tt.status) {
+				t.Errorf("got type %v and title %v, want %v and %v", problem.Type, problem.Title, tt.problemType, http.StatusText(tt.status))
+			}
+			if problem.Detail == "" || problem.Message != problem.Detail || problem.Code != tt.status {
+				t.Errorf("got %+v, want the detail and the code and message extension members", problem)
+			}
+			if problem.RequestId == "" {
+				t.Errorf("got no request ID, want the ID of the request")
+			}
+			var names []string
+			for _, param := range problem.InvalidParams {
+				names = append(names, param.Name)
+			}
+			if fmt.Sprint(names) != fmt.Sprint(tt.invalidParams) {
+				t.Errorf("got invalid params %v, want %v", names, tt.invalidParams)
+			}
+		})
+	}
+}
+
+// TestSecurityMiddlewareProblems tests that the security middleware responds with problem details.
+func TestSecurityMiddlewareProblems(t *testing.T) {
+	handler := NewSecurityMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	for _, apiKey := range []string{"", "wrong"} {
+		t.Run(apiKey, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tax-calculator/", nil)
+			req = req.WithContext(context.WithValue(req.Context(), "apiKey.Scopes", []string{}))
+			if apiKey != "" {
+				req.Header.Set("X-Api-Key", apiKey)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			problem := readProblem(t, rec)
+			if problem.Status != http.StatusUnauthorized || problem.Type != ProblemTypeUnauthorized || problem.Field != "X-Api-Key" {
+				t.Errorf("got %+v, want an unauthorized problem about X-Api-Key", problem)
+			}
+		})
+	}
+}
+
+// TestTimeoutMiddlewareProblem tests that the requests timing out are answered with a 504 problem.
+func TestTimeoutMiddlewareProblem(t *testing.T) {
+	handler := NewTimeoutMiddleware(time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tax-calculator/tax-years", nil))
+	problem := readProblem(t, rec)
+	if rec.Code != http.StatusGatewayTimeout || problem.Status != http.StatusGatewayTimeout || problem.Type != ProblemTypeTimeout {
+		t.Errorf("got status %v and %+v, want a timeout problem", rec.Code, problem)
+	}
+
+	handler = NewTimeoutMiddleware(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tax-calculator/tax-years", nil))
+	if rec.Code != http.StatusNoContent || rec.Body.Len() != 0 {
+		t.Errorf("got status %v and body %v, want the response of the handler", rec.Code, rec.Body.String())
+	}
+}
+
+// TestRecovererMiddlewareProblem tests that the panics of the handlers are answered with a 500 problem.
+func TestRecovererMiddlewareProblem(t *testing.T) {
+	handler := NewRecovererMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("unexpected")
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tax-calculator/tax-years", nil))
+	problem := readProblem(t, rec)
+	if rec.Code != http.StatusInternalServerError || problem.Status != http.StatusInternalServerError || problem.Type != ProblemTypeInternalError {
+		t.Errorf("got status %v and %+v, want an internal error problem", rec.Code, problem)
+	}
+
+	for _, connection := range []string{"Upgrade", "keep-alive, upgrade"} {
+		rec = httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/tax-calculator/live", nil)
+		req.Header.Set("Connection", connection)
+		handler.ServeHTTP(rec, req)
+		if rec.Body.Len() != 0 {
+			t.Errorf("got body %v for the connection %v, want no problem written to an upgraded connection", rec.Body.String(), connection)
+		}
+	}
+}
+
+// TestParamError tests that the parameter binding errors report the invalid parameter.
+func TestParamError(t *testing.T) {
+	err := paramError(&api.InvalidParamFormatError{ParamName: "year", Err: fmt.Errorf("invalid")})
+	if err.Code != http.StatusBadRequest || err.Field != "year" {
+		t.Errorf("got %v, want a bad request about the year", *err)
+	}
+}
+
+// TestFieldPath tests the fieldPath function.
+func TestFieldPath(t *testing.T) {
+	var tests = []struct {
+		pointer []string
+		want    string
+	}{
+		{[]string{"salary"}, "salary"},
+		{[]string{"income_items", "0", "currency"}, "income_items[0].currency"},
+		{[]string{"scenarios", "1"}, "scenarios[1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if ans := fieldPath(tt.pointer); ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+// readProblem reads the problem details of the response.
+func readProblem(t *testing.T, rec *httptest.ResponseRecorder) api.ErrorResponses {
+	t.Helper()
+	if contentType := rec.Header().Get("Content-Type"); contentType != ContentTypeProblemJSON {
+		t.Fatalf("got content type %v, want %v: %v", contentType, ContentTypeProblemJSON, rec.Body.String())
+	}
+	var problem api.ErrorResponses
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	return problem
+}
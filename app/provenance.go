@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"patrickyau/interview-test-server/api"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetTaxYearProvenance returns where the tax brackets of the given year come from.
+func (s *TaxService) GetTaxYearProvenance(ctx context.Context, request api.GetTaxYearProvenanceRequestObject) (api.GetTaxYearProvenanceResponseObject, error) {
+	year, err := s.resolveYear(request.Year)
+	if err != nil {
+		return api.GetTaxYearProvenance404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+
+	if err := ValidateYear(year); err != nil {
+		return api.GetTaxYearProvenance400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+
+	provenance, err := GetTaxYearProvenance(year)
+	if err != nil {
+		return api.GetTaxYearProvenance404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.GetTaxYearProvenance200JSONResponse{
+		Body: mapProvenanceToAPITaxYearProvenance(year, provenance),
+		Headers: api.GetTaxYearProvenance200ResponseHeaders{
+			XTaxYear: year,
+		},
+	}, nil
+}
+
+// provenanceLink returns the Link header pointing to the provenance of the tax brackets of the year.
+func provenanceLink(year string) string {
+	return fmt.Sprintf(`</tax-calculator/tax-years/%v/provenance>; rel="describedby"`, year)
+}
+
+// mapProvenanceToAPITaxYearProvenance maps the Provenance of a year to an api.TaxYearProvenance.
+func mapProvenanceToAPITaxYearProvenance(year string, provenance Provenance) api.TaxYearProvenance {
+	return api.TaxYearProvenance{
+		Year:            year,
+		Source:          provenance.Source,
+		SourceUrl:       provenance.SourceURL,
+		PublicationDate: mapDateToAPIDate(provenance.PublicationDate),
+		LastVerified:    mapDateToAPIDate(provenance.LastVerified),
+		Notes:           provenance.Notes,
+		DatasetVersion:  DatasetVersion,
+	}
+}
+
+// mapDateToAPIDate maps a date formatted as 2006-01-02 to an api date, or nil if it is empty.
+func mapDateToAPIDate(date string) *openapi_types.Date {
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return nil
+	}
+	return &openapi_types.Date{Time: t}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"patrickyau/interview-test-server/api"
+	"reflect"
+	"testing"
+)
+
+// TestGetTaxYearProvenance tests the GetTaxYearProvenance handler.
+func TestGetTaxYearProvenance(t *testing.T) {
+	s := NewTaxService()
+	var tests = []struct {
+		year string
+		want string
+	}{
+		{"2000", "api.GetTaxYearProvenance200JSONResponse"},
+		{"2023", "api.GetTaxYearProvenance200JSONResponse"},
+		{"1999", "api.GetTaxYearProvenance404ApplicationProblemPlusJSONResponse"},
+		{"20x0", "api.GetTaxYearProvenance400ApplicationProblemPlusJSONResponse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.year, func(t *testing.T) {
+			ans, err := s.GetTaxYearProvenance(context.Background(), api.GetTaxYearProvenanceRequestObject{Year: tt.year})
+			if err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if got := fmt.Sprintf("%T", ans); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			if response, ok := ans.(api.GetTaxYearProvenance200JSONResponse); ok {
+				provenance := response.Body
+				if provenance.Year != tt.year || provenance.Source == "" || provenance.SourceUrl == "" {
+					t.Errorf("got %+v, want the year and a source", provenance)
+				}
+				if provenance.DatasetVersion != DatasetVersion || len(DatasetVersion) != 16 {
+					t.Errorf("got dataset version %v, want %v", provenance.DatasetVersion, DatasetVersion)
+				}
+			}
+		})
+	}
+}
+
+// TestGetTaxCalculatorByYearProvenance tests that the tax brackets include and link to their provenance.
+func TestGetTaxCalculatorByYearProvenance(t *testing.T) {
+	s := NewTaxService()
+	ans, err := s.GetTaxCalculatorByYear(context.Background(), api.GetTaxCalculatorByYearRequestObject{Year: "2022"})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	response, ok := ans.(api.GetTaxCalculatorByYear200JSONResponse)
+	if !ok {
+		t.Fatalf("got %T, want api.GetTaxCalculatorByYear200JSONResponse", ans)
+	}
+	if response.Headers.XDatasetVersion != DatasetVersion {
+		t.Errorf("got dataset version %v, want %v", response.Headers.XDatasetVersion, DatasetVersion)
+	}
+	if want := `</tax-calculator/tax-years/2022/provenance>; rel="describedby"`; response.Headers.Link != want {
+		t.Errorf("got link %v, want %v", response.Headers.Link, want)
+	}
+	provenance, provenanceErr := GetTaxYearProvenance("2022")
+	if provenanceErr != nil {
+		t.Fatalf("got error %v, want nil", *provenanceErr)
+	}
+	body := response.Body
+	if body.Year != "2022" || body.DatasetVersion != DatasetVersion || len(body.Brackets) != len(TaxBrackets["2022"]) {
+		t.Errorf("got %+v, want the 2022 brackets of the dataset %v", body, DatasetVersion)
+	}
+	if want := mapProvenanceToAPITaxYearProvenance("2022", provenance); !reflect.DeepEqual(body.Provenance, want) {
+		t.Errorf("got provenance %+v, want %+v", body.Provenance, want)
+	}
+}
+
+// TestCalculateProvenance tests that the Canadian calculations are traced to the provenance of their tax brackets.
+func TestCalculateProvenance(t *testing.T) {
+	s := NewTaxService()
+	taxOwed, err := s.calculate("2022", api.CalculateRequest{Salary: 50000})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	if taxOwed.Provenance == nil || taxOwed.Provenance.SourceURL == "" || taxOwed.DatasetVersion != DatasetVersion {
+		t.Errorf("got provenance %v and dataset version %v, want the 2022 provenance and %v", taxOwed.Provenance, taxOwed.DatasetVersion, DatasetVersion)
+	}
+
+	taxOwed, err = s.calculate("2022", api.CalculateRequest{Salary: 50000, Jurisdiction: JurisdictionUS, FilingStatus: FilingStatusSingle})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	if taxOwed.Provenance != nil {
+		t.Errorf("got provenance %v, want nil for the US jurisdiction", taxOwed.Provenance)
+	}
+}
+
+// TestDatasetVersionCodedData tests that the dataset version changes with the brackets coded in Go.
+func TestDatasetVersionCodedData(t *testing.T) {
+	version := datasetVersion(codedTaxData())
+	brackets := ProvincialTaxBrackets[ProvinceOntario]["2023"]
+	t.Cleanup(func() { ProvincialTaxBrackets[ProvinceOntario]["2023"] = brackets })
+
+	changed := append([]TaxBracket{}, brackets...)
+	changed[0].Rate = 0.06
+	ProvincialTaxBrackets[ProvinceOntario]["2023"] = changed
+	if ans := datasetVersion(codedTaxData()); ans == version {
+		t.Errorf("got dataset version %v with another provincial rate, want it to change", ans)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+// Provinces with provincial tax brackets, by their postal abbreviation.
+const (
+	ProvinceAlberta         = "AB"
+	ProvinceBritishColumbia = "BC"
+	ProvinceOntario         = "ON"
+	ProvinceQuebec          = "QC"
+)
+
+// ProvincialTaxAuthorities represents the authorities that provincial returns are filed with, when it is
+// not the Canada Revenue Agency.
+var ProvincialTaxAuthorities = map[string]string{
+	ProvinceQuebec: "Revenu Québec",
+}
+
+// ProvincialTaxBrackets represents the provincial tax brackets for all supported provinces and years.
+var ProvincialTaxBrackets = map[string]map[string][]TaxBracket{
+	ProvinceAlberta: {
+		"2019": bracketsFromThresholds([]float64{0.10, 0.12, 0.13, 0.14, 0.15}, 131220, 157464, 209952, 314928),
+		"2020": bracketsFromThresholds([]float64{0.10, 0.12, 0.13, 0.14, 0.15}, 131220, 157464, 209952, 314928),
+		"2021": bracketsFromThresholds([]float64{0.10, 0.12, 0.13, 0.14, 0.15}, 131220, 157464, 209952, 314928),
+		"2022": bracketsFromThresholds([]float64{0.10, 0.12, 0.13, 0.14, 0.15}, 134238, 161086, 214781, 322171),
+		"2023": bracketsFromThresholds([]float64{0.10, 0.12, 0.13, 0.14, 0.15}, 142292, 170751, 227668, 341502),
+	},
+	ProvinceBritishColumbia: {
+		"2019": bracketsFromThresholds([]float64{0.0506, 0.077, 0.105, 0.1229, 0.147, 0.168}, 40707, 81416, 93476, 113506, 153900),
+		"2020": bracketsFromThresholds([]float64{0.0506, 0.077, 0.105, 0.1229, 0.147, 0.168, 0.205}, 41725, 83451, 95812, 116344, 157748, 220000),
+		"2021": bracketsFromThresholds([]float64{0.0506, 0.077, 0.105, 0.1229, 0.147, 0.168, 0.205}, 42184, 84369, 96866, 117623, 159483, 222420),
+		"2022": bracketsFromThresholds([]float64{0.0506, 0.077, 0.105, 0.1229, 0.147, 0.168, 0.205}, 43070, 86141, 98901, 120094, 162832, 227091),
+		"2023": bracketsFromThresholds([]float64{0.0506, 0.077, 0.105, 0.1229, 0.147, 0.168, 0.205}, 45654, 91310, 104835, 127299, 172602, 240716),
+	},
+	ProvinceOntario: {
+		"2019": bracketsFromThresholds([]float64{0.0505, 0.0915, 0.1116, 0.1216, 0.1316}, 43906, 87813, 150000, 220000),
+		"2020": bracketsFromThresholds([]float64{0.0505, 0.0915, 0.1116, 0.1216, 0.1316}, 44740, 89482, 150000, 220000),
+		"2021": bracketsFromThresholds([]float64{0.0505, 0.0915, 0.1116, 0.1216, 0.1316}, 45142, 90287, 150000, 220000),
+		"2022": bracketsFromThresholds([]float64{0.0505, 0.0915, 0.1116, 0.1216, 0.1316}, 46226, 92454, 150000, 220000),
+		"2023": bracketsFromThresholds([]float64{0.0505, 0.0915, 0.1116, 0.1216, 0.1316}, 49231, 98463, 150000, 220000),
+	},
+	ProvinceQuebec: {
+		"2019": bracketsFromThresholds([]float64{0.15, 0.20, 0.24, 0.2575}, 43790, 87575, 106555),
+		"2020": bracketsFromThresholds([]float64{0.15, 0.20, 0.24, 0.2575}, 44545, 89080, 108390),
+		"2021": bracketsFromThresholds([]float64{0.15, 0.20, 0.24, 0.2575}, 45105, 90200, 109755),
+		"2022": bracketsFromThresholds([]float64{0.15, 0.20, 0.24, 0.2575}, 46295, 92580, 112655),
+		"2023": bracketsFromThresholds([]float64{0.14, 0.19, 0.24, 0.2575}, 49275, 98540, 119910),
+	},
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// Layout of the calculation report, in millimetres on a Letter page.
+const (
+	reportMargin     = 20
+	reportLineHeight = 5
+	reportLabelWidth = 60
+	// reportMaxIncomeItems is how many income items are listed; the others are summarized in one row, so the report
+	// fits on one page.
+	reportMaxIncomeItems = 3
+)
+
+// reportBandColumns are the columns of the table of the tax owed per band, with their widths.
+var reportBandColumns = []struct {
+	title string
+	width float64
+}{
+	{"Level", 26},
+	{"From", 28},
+	{"To", 28},
+	{"Rate", 20},
+	{"Taxable amount", 36},
+	{"Tax owed", 37.9},
+}
+
+// report writes the sections of a calculation report on a PDF page.
+type report struct {
+	pdf *fpdf.Fpdf
+	// translate translates UTF-8 text to the code page of the core fonts, e.g. for Revenu Québec.
+	translate func(string) string
+}
+
+// WriteCalculationReport writes a one-page PDF report of the calculation, with its inputs, the tax owed per band,
+// the totals, the effective and marginal rates, the dataset version and the time it was generated at.
+func WriteCalculationReport(w io.Writer, taxOwed TaxOwed, generatedAt time.Time) error {
+	pdf := fpdf.New("P", "mm", "Letter", "")
+	pdf.SetMargins(reportMargin, reportMargin, reportMargin)
+	pdf.SetAutoPageBreak(false, reportMargin)
+	pdf.SetCreationDate(generatedAt)
+	pdf.SetModificationDate(generatedAt)
+	pdf.SetCatalogSort(true)
+	pdf.SetTitle(fmt.Sprintf("Tax calculation report %v", taxOwed.TaxYear), true)
+	pdf.SetCreator("Interview Test Server", true)
+	pdf.AddPage()
+	r := report{pdf: pdf, translate: pdf.UnicodeTranslatorFromDescriptor("")}
+	currency := JurisdictionCurrencies[taxOwed.Jurisdiction]
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, "Tax calculation report", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, reportLineHeight, fmt.Sprintf("Tax year %v, amounts in %v", taxOwed.TaxYear, currency), "", 1, "L", false, 0, "")
+
+	r.heading("Inputs")
+	r.row("Jurisdiction", taxOwed.Jurisdiction)
+	if taxOwed.FilingStatus != "" {
+		r.row("Filing status", taxOwed.FilingStatus)
+	}
+	if taxOwed.Province != "" {
+		r.row("Province", fmt.Sprintf("%v (%v)", taxOwed.Province, ProvincialTaxAuthority(taxOwed.Province)))
+	}
+	if taxOwed.Residency != nil {
+		r.row("Residency", taxOwed.Residency.Status)
+	}
+	r.row("Salary", formatAmount(taxOwed.Salary))
+	r.incomeItems(taxOwed.IncomeItems, currency)
+	if taxOwed.Deductions > 0 {
+		r.row("Deductions", formatAmount(taxOwed.Deductions))
+	}
+	if taxOwed.StandardDeduction > 0 {
+		r.row("Standard deduction", formatAmount(taxOwed.StandardDeduction))
+	}
+	if taxOwed.TaxWithheld > 0 || taxOwed.InstalmentsPaid > 0 {
+		r.row("Tax withheld", formatAmount(taxOwed.TaxWithheld))
+		r.row("Instalments paid", formatAmount(taxOwed.InstalmentsPaid))
+	}
+
+	r.heading("Tax owed per band")
+	r.bands(taxOwed)
+
+	r.heading("Totals")
+	r.row("Total income", formatAmount(taxOwed.TotalIncome))
+	r.row("Taxable income", formatAmount(taxOwed.TaxableIncome))
+	r.row("Federal tax owed", formatAmount(taxOwed.FederalTaxOwed))
+	if taxOwed.Province != "" {
+		r.row("Provincial tax owed", formatAmount(taxOwed.ProvincialTaxOwed))
+	}
+	r.row("Total tax owed", formatAmount(taxOwed.TotalTaxOwed))
+	for _, contribution := range taxOwed.Contributions {
+		r.row("Contribution "+contribution.Name, formatAmount(contribution.Amount))
+	}
+	r.row("Net income", formatAmount(taxOwed.NetIncome))
+	if taxOwed.TaxWithheld > 0 || taxOwed.InstalmentsPaid > 0 {
+		r.row("Refund", formatAmount(taxOwed.Refund))
+		r.row("Balance owing", formatAmount(taxOwed.BalanceOwing))
+	}
+
+	r.heading("Rates")
+	r.row("Effective tax rate", taxOwed.EffectiveTaxRate)
+	r.row("Marginal tax rate", taxOwed.Rounding.FormatRate(taxOwed.MarginalTaxRate))
+
+	_, pageHeight := pdf.GetPageSize()
+	footerY := pageHeight - reportMargin - 3*reportLineHeight
+	if pdf.GetY()+reportLineHeight > footerY {
+		return fmt.Errorf("the report of the calculation does not fit on one page")
+	}
+	pdf.SetY(footerY)
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.SetTextColor(96, 96, 96)
+	if taxOwed.Provenance != nil {
+		pdf.CellFormat(0, reportLineHeight, r.translate("Federal brackets: "+taxOwed.Provenance.Source), "", 1, "L", false, 0, "")
+	}
+	pdf.CellFormat(0, reportLineHeight, fmt.Sprintf("Dataset version %v", taxOwed.DatasetVersion), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, reportLineHeight, fmt.Sprintf("Generated at %v", generatedAt.UTC().Format(time.RFC3339)), "", 1, "L", false, 0, "")
+	return pdf.Output(w)
+}
+
+// heading writes the heading of a section.
+func (r report) heading(title string) {
+	r.pdf.Ln(reportLineHeight / 2)
+	r.pdf.SetFont("Helvetica", "B", 12)
+	r.pdf.CellFormat(0, 7, title, "B", 1, "L", false, 0, "")
+	r.pdf.SetFont("Helvetica", "", 10)
+}
+
+// row writes a label and its value.
+func (r report) row(label string, value string) {
+	r.pdf.CellFormat(reportLabelWidth, reportLineHeight, r.translate(label), "", 0, "L", false, 0, "")
+	r.pdf.CellFormat(0, reportLineHeight, r.translate(value), "", 1, "L", false, 0, "")
+}
+
+// incomeItems writes the first income items, and the number and the converted total of the others.
+func (r report) incomeItems(items []IncomeItem, currency string) {
+	for i, item := range items {
+		if i == reportMaxIncomeItems {
+			var others float64
+			for _, item := range items[i:] {
+				others += item.ConvertedAmount
+			}
+			r.row(fmt.Sprintf("%d other income items", len(items)-i), fmt.Sprintf("%v %v", formatAmount(roundCents(others)), currency))
+			return
+		}
+		r.row(item.Description, fmt.Sprintf("%v %v at %v", formatAmount(item.Amount), item.Currency, item.ExchangeRate))
+	}
+}
+
+// bands writes the table of the federal and provincial tax owed per band.
+func (r report) bands(taxOwed TaxOwed) {
+	r.pdf.SetFont("Helvetica", "B", 10)
+	r.pdf.SetFillColor(230, 230, 230)
+	for i, column := range reportBandColumns {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		r.pdf.CellFormat(column.width, reportLineHeight, column.title, "", 0, align, true, 0, "")
+	}
+	r.pdf.Ln(-1)
+	r.pdf.SetFont("Helvetica", "", 10)
+
+	levels := []struct {
+		name           string
+		taxOwedPerBand []TaxBracket
+	}{
+		{"Federal", taxOwed.TaxOwedPerBand},
+		{"Provincial", taxOwed.ProvincialTaxOwedPerBand},
+	}
+	for _, level := range levels {
+		for _, band := range mapTaxBracketsToAPIBandsV2(taxOwed.TaxableIncome, level.taxOwedPerBand) {
+			to := "and over"
+			if band.Max != nil {
+				to = formatAmount(*band.Max)
+			}
+			cells := []string{
+				level.name,
+				formatAmount(band.Min),
+				to,
+				strconv.FormatFloat(band.Rate*100, 'f', -1, 64) + "%",
+				formatAmount(band.TaxableAmount),
+				formatAmount(band.TaxOwed),
+			}
+			for i, cell := range cells {
+				align := "R"
+				if i == 0 {
+					align = "L"
+				}
+				r.pdf.CellFormat(reportBandColumns[i].width, reportLineHeight, cell, "", 0, align, false, 0, "")
+			}
+			r.pdf.Ln(-1)
+		}
+	}
+}
+
+// formatAmount formats an amount with the cents and a comma between the thousands, e.g. 1,234,567.00.
+func formatAmount(amount float64) string {
+	formatted := strconv.FormatFloat(amount, 'f', 2, 64)
+	sign := ""
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+	whole, cents, _ := strings.Cut(formatted, ".")
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return sign + grouped.String() + "." + cents
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"patrickyau/interview-test-server/api"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// TestFormatAmount tests the formatAmount function.
+func TestFormatAmount(t *testing.T) {
+	var tests = []struct {
+		amount float64
+		want   string
+	}{
+		{0, "0.00"},
+		{999.5, "999.50"},
+		{1000, "1,000.00"},
+		{50197, "50,197.00"},
+		{1234567, "1,234,567.00"},
+		{-12345.67, "-12,345.67"},
+	}
+	for _, tt := range tests {
+		if ans := formatAmount(tt.amount); ans != tt.want {
+			t.Errorf("formatAmount(%v): got %v, want %v", tt.amount, ans, tt.want)
+		}
+	}
+}
+
+// TestWriteCalculationReport tests that a calculation is rendered as a deterministic one-page PDF.
+func TestWriteCalculationReport(t *testing.T) {
+	generatedAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name  string
+		year  string
+		input api.CalculateRequest
+	}{
+		{"federal", "2022", api.CalculateRequest{Salary: 1234567}},
+		{"Quebec", "2023", api.CalculateRequest{Salary: 100000, Province: "QC", TaxWithheld: 20000}},
+		{"US", "2023", api.CalculateRequest{Salary: 100000, Jurisdiction: "US", FilingStatus: "single"}},
+		{"many income items", "2023", api.CalculateRequest{
+			Salary:             100000,
+			Province:           "QC",
+			Deductions:         5000,
+			TaxWithheld:        20000,
+			InstalmentsPaid:    1000,
+			ResidencyStartDate: mapDateToAPIDate("2023-03-01"),
+			IncomeItems:        manyIncomeItems(100),
+		}},
+	}
+	s := NewTaxService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxOwed, err := s.calculate(tt.year, tt.input)
+			if err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			var first, second bytes.Buffer
+			if err := WriteCalculationReport(&first, taxOwed, generatedAt); err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if err := WriteCalculationReport(&second, taxOwed, generatedAt); err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if !bytes.HasPrefix(first.Bytes(), []byte("%PDF-")) {
+				t.Errorf("got %q, want a PDF", first.Bytes()[:8])
+			}
+			if !bytes.Contains(first.Bytes(), []byte("/Count 1")) {
+				t.Errorf("got a PDF without /Count 1, want one page")
+			}
+			if !bytes.Equal(first.Bytes(), second.Bytes()) {
+				t.Errorf("got different PDFs for the same calculation, want the same")
+			}
+		})
+	}
+}
+
+// TestReportIncomeItems tests that the income items after the first ones are summarized in one row.
+func TestReportIncomeItems(t *testing.T) {
+	var tests = []struct {
+		items int
+		want  float64
+	}{
+		{0, 0},
+		{reportMaxIncomeItems, reportMaxIncomeItems},
+		{reportMaxIncomeItems + 1, reportMaxIncomeItems + 1},
+		{100, reportMaxIncomeItems + 1},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.items), func(t *testing.T) {
+			pdf := fpdf.New("P", "mm", "Letter", "")
+			pdf.AddPage()
+			pdf.SetFont("Helvetica", "", 10)
+			r := report{pdf: pdf, translate: pdf.UnicodeTranslatorFromDescriptor("")}
+			start := pdf.GetY()
+			var items []IncomeItem
+			for _, item := range manyIncomeItems(tt.items) {
+				items = append(items, IncomeItem{Description: item.Description, Amount: item.Amount, Currency: item.Currency, ExchangeRate: 1, ConvertedAmount: item.Amount})
+			}
+			r.incomeItems(items, CurrencyCAD)
+			if rows := (pdf.GetY() - start) / reportLineHeight; math.Abs(rows-tt.want) > 1e-9 {
+				t.Errorf("got %v rows, want %v", rows, tt.want)
+			}
+		})
+	}
+}
+
+// manyIncomeItems returns the given number of USD income items.
+func manyIncomeItems(n int) []api.IncomeItemInput {
+	items := make([]api.IncomeItemInput, n)
+	for i := range items {
+		items[i] = api.IncomeItemInput{Description: fmt.Sprintf("US consulting %d", i+1), Amount: 100, Currency: "USD"}
+	}
+	return items
+}
+
+// TestCalculateReport tests that the calculation endpoint responds with the PDF report.
+func TestCalculateReport(t *testing.T) {
+	s := NewTaxService()
+	s.Clock = func() time.Time { return time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC) }
+	router, err := NewRouter(s)
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	for _, tt := range []struct{ path, accept string }{
+		{"/tax-calculator/tax-years/2022/calculate.pdf", ""},
+		{"/tax-calculator/tax-years/2022/calculate", "application/pdf"},
+	} {
+		t.Run(tt.path+"/"+tt.accept, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{"salary": 60000, "province": "ON"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", tt.accept)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			if contentType := rec.Header().Get("Content-Type"); contentType != ContentTypePDF {
+				t.Errorf("got content type %v, want %v", contentType, ContentTypePDF)
+			}
+			if taxYear := rec.Header().Get("X-Tax-Year"); taxYear != "2022" {
+				t.Errorf("got X-Tax-Year %v, want 2022", taxYear)
+			}
+			if !strings.HasPrefix(rec.Body.String(), "%PDF-") {
+				t.Errorf("got body %q, want a PDF", rec.Body.String()[:8])
+			}
+		})
+	}
+}
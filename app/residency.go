@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"strconv"
+	"time"
+)
+
+// Residency statuses of the taxpayer for the tax year.
+const (
+	ResidencyStatusResident         = "resident"
+	ResidencyStatusPartYearResident = "part_year_resident"
+	ResidencyStatusNonResident      = "non_resident"
+)
+
+// nonResidentCreditThreshold is the share of the salary that must be Canadian-source income for a
+// non-resident to claim the non-refundable credits.
+const nonResidentCreditThreshold = 0.9
+
+// Residency represents the residency of the taxpayer for the tax year and the proration factors it implies.
+type Residency struct {
+	Status       string `json:"status"`
+	DaysResident int    `json:"days_resident"`
+	DaysInYear   int    `json:"days_in_year"`
+	// CreditProrationFactor prorates the non-refundable credits.
+	CreditProrationFactor float64 `json:"credit_proration_factor"`
+	// IncomeProrationFactor is the share of the salary that is taxed.
+	IncomeProrationFactor float64 `json:"income_proration_factor"`
+}
+
+// NewResidency validates the residency of the calculation input for the year and returns its proration factors.
+// Part-year residents have their non-refundable credits prorated by the days resident. Non-residents are
+// taxed on their Canadian-source income only and cannot claim the non-refundable credits unless it is at
+// least 90% of their salary.
+func NewResidency(jurisdiction string, year string, input api.CalculateRequest) (Residency, *Err) {
+	partYear := input.ResidencyStartDate != nil || input.ResidencyEndDate != nil
+	nonResident := input.NonResident || input.CanadianSourceIncome != 0
+	if (partYear || nonResident) && jurisdiction != JurisdictionCanada {
+		return Residency{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "non_resident",
+			Message: fmt.Sprintf("the residency is not supported for the jurisdiction %v", jurisdiction),
+		}
+	}
+
+	taxYear, _ := strconv.Atoi(year)
+	firstDay := time.Date(taxYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(taxYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+	daysInYear := daysBetween(firstDay, lastDay)
+	residency := Residency{
+		Status:                ResidencyStatusResident,
+		DaysResident:          daysInYear,
+		DaysInYear:            daysInYear,
+		CreditProrationFactor: 1,
+		IncomeProrationFactor: 1,
+	}
+
+	switch {
+	case nonResident:
+		if partYear || !input.NonResident {
+			return Residency{}, &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "non_resident",
+				Message: "the Canadian-source income is only supported for non-residents, who have no residency dates",
+			}
+		}
+		if input.CanadianSourceIncome < 0 || input.CanadianSourceIncome > input.Salary {
+			return Residency{}, &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "canadian_source_income",
+				Message: fmt.Sprintf("the Canadian-source income must be between 0 and the salary. Invalid value: %.2f", input.CanadianSourceIncome),
+			}
+		}
+		residency.Status = ResidencyStatusNonResident
+		residency.DaysResident = 0
+		residency.CreditProrationFactor = 0
+		if input.Salary > 0 {
+			residency.IncomeProrationFactor = input.CanadianSourceIncome / input.Salary
+		}
+		if residency.IncomeProrationFactor >= nonResidentCreditThreshold {
+			residency.CreditProrationFactor = 1
+		}
+	case partYear:
+		start, end := firstDay, lastDay
+		if input.ResidencyStartDate != nil {
+			start = input.ResidencyStartDate.Time
+		}
+		if input.ResidencyEndDate != nil {
+			end = input.ResidencyEndDate.Time
+		}
+		if start.Before(firstDay) || start.After(lastDay) {
+			return Residency{}, &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "residency_start_date",
+				Message: fmt.Sprintf("the residency start date %v is not in the tax year %v", start.Format(time.DateOnly), year),
+			}
+		}
+		if end.Before(start) || end.After(lastDay) {
+			return Residency{}, &Err{
+				Code:    http.StatusBadRequest,
+				Field:   "residency_end_date",
+				Message: fmt.Sprintf("the residency end date %v is not between the start date and the end of the tax year %v", end.Format(time.DateOnly), year),
+			}
+		}
+		residency.DaysResident = daysBetween(start, end)
+		if residency.DaysResident < daysInYear {
+			residency.Status = ResidencyStatusPartYearResident
+			residency.CreditProrationFactor = float64(residency.DaysResident) / float64(daysInYear)
+		}
+	}
+	return residency, nil
+}
+
+// daysBetween returns the number of days from start to end, both included.
+func daysBetween(start time.Time, end time.Time) int {
+	return int(end.Sub(start).Hours()/24) + 1
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// date returns the date for the tests.
+func date(value string) *openapi_types.Date {
+	t, _ := time.Parse(time.DateOnly, value)
+	return &openapi_types.Date{Time: t}
+}
+
+// TestNewResidency tests the NewResidency function.
+func TestNewResidency(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input api.CalculateRequest
+		want  Residency
+		error int
+	}{
+		{
+			"resident",
+			api.CalculateRequest{Salary: 50000},
+			Residency{Status: ResidencyStatusResident, DaysResident: 365, DaysInYear: 365, CreditProrationFactor: 1, IncomeProrationFactor: 1},
+			0,
+		},
+		{
+			"immigrant",
+			api.CalculateRequest{Salary: 50000, ResidencyStartDate: date("2023-07-01")},
+			Residency{Status: ResidencyStatusPartYearResident, DaysResident: 184, DaysInYear: 365, CreditProrationFactor: 184.0 / 365, IncomeProrationFactor: 1},
+			0,
+		},
+		{
+			"emigrant",
+			api.CalculateRequest{Salary: 50000, ResidencyEndDate: date("2023-01-31")},
+			Residency{Status: ResidencyStatusPartYearResident, DaysResident: 31, DaysInYear: 365, CreditProrationFactor: 31.0 / 365, IncomeProrationFactor: 1},
+			0,
+		},
+		{
+			"non-resident",
+			api.CalculateRequest{Salary: 50000, NonResident: true, CanadianSourceIncome: 20000},
+			Residency{Status: ResidencyStatusNonResident, DaysInYear: 365, CreditProrationFactor: 0, IncomeProrationFactor: 0.4},
+			0,
+		},
+		{
+			"non-resident with mostly Canadian-source income",
+			api.CalculateRequest{Salary: 50000, NonResident: true, CanadianSourceIncome: 45000},
+			Residency{Status: ResidencyStatusNonResident, DaysInYear: 365, CreditProrationFactor: 1, IncomeProrationFactor: 0.9},
+			0,
+		},
+		{
+			"start date in another year",
+			api.CalculateRequest{Salary: 50000, ResidencyStartDate: date("2022-07-01")},
+			Residency{},
+			http.StatusBadRequest,
+		},
+		{
+			"end date before start date",
+			api.CalculateRequest{Salary: 50000, ResidencyStartDate: date("2023-07-01"), ResidencyEndDate: date("2023-06-30")},
+			Residency{},
+			http.StatusBadRequest,
+		},
+		{
+			"non-resident with residency dates",
+			api.CalculateRequest{Salary: 50000, NonResident: true, ResidencyStartDate: date("2023-07-01")},
+			Residency{},
+			http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ans, err := NewResidency(JurisdictionCanada, "2023", tt.input)
+			if err != nil {
+				if err.Code != tt.error {
+					t.Errorf("got error %v, want code %v", *err, tt.error)
+				}
+				return
+			}
+			if tt.error != 0 {
+				t.Fatalf("got no error, want code %v", tt.error)
+			}
+			if ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+// TestCalculatePartYearResident tests that the non-refundable credits of part-year residents are prorated.
+func TestCalculatePartYearResident(t *testing.T) {
+	s := NewTaxService()
+	taxOwed, err := s.calculate("2023", api.CalculateRequest{Salary: 50000, Province: ProvinceOntario, ResidencyStartDate: date("2023-07-01")})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	want := roundCents(15000 * 0.15 * 184 / 365)
+	if taxOwed.Credits[0].Amount != want {
+		t.Errorf("got federal basic personal amount %v, want %v", taxOwed.Credits[0].Amount, want)
+	}
+	if taxOwed.Residency == nil || taxOwed.Residency.Status != ResidencyStatusPartYearResident {
+		t.Errorf("got residency %v, want %v", taxOwed.Residency, ResidencyStatusPartYearResident)
+	}
+}
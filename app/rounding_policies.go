@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// Rounding modes: round every line of the calculation, e.g. each band and credit, or only the totals.
+const (
+	RoundingModePerLine = "per_line"
+	RoundingModeTotal   = "total"
+)
+
+// Rounding methods for amounts exactly halfway between two values.
+const (
+	RoundingMethodHalfUp   = "half_up"
+	RoundingMethodHalfEven = "half_even"
+)
+
+// Rounding precisions of the amounts.
+const (
+	RoundingPrecisionCents   = "cents"
+	RoundingPrecisionDollars = "dollars"
+)
+
+// RoundingPolicy represents how the amounts of a calculation are rounded, to match the official worksheets.
+type RoundingPolicy struct {
+	Mode      string `json:"mode"`
+	Method    string `json:"method"`
+	Precision string `json:"precision"`
+	// EffectiveRateDecimals is the number of decimals of the formatted effective tax rate.
+	EffectiveRateDecimals int `json:"effective_rate_decimals"`
+}
+
+// RoundingPolicies represents the rounding policy of every jurisdiction, stored with the brackets in the embedded
+// dataset. Canada rounds the totals to the cent, and the US to whole dollars like the IRS Tax Computation Worksheet.
+var RoundingPolicies = FederalTaxYears.RoundingPolicies
+
+// ValidateRoundingPolicy validates the rounding policy of a jurisdiction: its mode, method and precision are known,
+// and the effective rate has between 0 and 4 decimals.
+func ValidateRoundingPolicy(jurisdiction string, policy RoundingPolicy) error {
+	switch {
+	case policy.Mode != RoundingModePerLine && policy.Mode != RoundingModeTotal:
+		return fmt.Errorf("invalid rounding policy of '%v': the mode '%v' is not %v or %v", jurisdiction, policy.Mode, RoundingModePerLine, RoundingModeTotal)
+	case policy.Method != RoundingMethodHalfUp && policy.Method != RoundingMethodHalfEven:
+		return fmt.Errorf("invalid rounding policy of '%v': the method '%v' is not %v or %v", jurisdiction, policy.Method, RoundingMethodHalfUp, RoundingMethodHalfEven)
+	case policy.Precision != RoundingPrecisionCents && policy.Precision != RoundingPrecisionDollars:
+		return fmt.Errorf("invalid rounding policy of '%v': the precision '%v' is not %v or %v", jurisdiction, policy.Precision, RoundingPrecisionCents, RoundingPrecisionDollars)
+	case policy.EffectiveRateDecimals < 0 || policy.EffectiveRateDecimals > 4:
+		return fmt.Errorf("invalid rounding policy of '%v': the effective rate has %v decimals, want between 0 and 4", jurisdiction, policy.EffectiveRateDecimals)
+	}
+	return nil
+}
+
+// GetRoundingPolicy returns the rounding policy of the jurisdiction, or the Canadian one by default.
+func GetRoundingPolicy(jurisdiction string) RoundingPolicy {
+	if policy, ok := RoundingPolicies[jurisdiction]; ok {
+		return policy
+	}
+	return RoundingPolicies[JurisdictionCanada]
+}
+
+// Round rounds the amount to the precision of the policy.
+func (p RoundingPolicy) Round(amount float64) float64 {
+	scale := 100.0
+	if p.Precision == RoundingPrecisionDollars {
+		scale = 1
+	}
+	if p.Method == RoundingMethodHalfEven {
+		return math.RoundToEven(exactScaled(amount, scale)) / scale
+	}
+	return math.Round(exactScaled(amount, scale)) / scale
+}
+
+// exactScaled returns the amount multiplied by the scale, without the binary representation error that would round a
+// half down, e.g. 46641 * 0.205 is 9561.404999999999 instead of 9561.405.
+func exactScaled(amount float64, scale float64) float64 {
+	return math.Round(amount*scale*1e4) / 1e4
+}
+
+// RoundLine rounds an amount of a line of the calculation, e.g. a band or a credit, when every line is rounded.
+func (p RoundingPolicy) RoundLine(amount float64) float64 {
+	if p.Mode == RoundingModePerLine {
+		return p.Round(amount)
+	}
+	return amount
+}
+
+// RoundRate rounds a rate, as a fraction, to the decimals of the policy when it is formatted as a percentage.
+func (p RoundingPolicy) RoundRate(rate float64) float64 {
+	scale := math.Pow10(p.EffectiveRateDecimals + 2)
+	return math.Round(rate*scale) / scale
+}
+
+// FormatRate formats a rate as a percentage with the decimals of the policy.
+func (p RoundingPolicy) FormatRate(rate float64) string {
+	return fmt.Sprintf("%.*f", p.EffectiveRateDecimals, rate*100) + "%"
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestRoundingPolicyRound tests the Round function of the rounding policies.
+func TestRoundingPolicyRound(t *testing.T) {
+	var tests = []struct {
+		method    string
+		precision string
+		amount    float64
+		want      float64
+	}{
+		{RoundingMethodHalfUp, RoundingPrecisionCents, 0.125, 0.13},
+		{RoundingMethodHalfEven, RoundingPrecisionCents, 0.125, 0.12},
+		{RoundingMethodHalfUp, RoundingPrecisionDollars, 2.5, 3},
+		{RoundingMethodHalfEven, RoundingPrecisionDollars, 2.5, 2},
+		{RoundingMethodHalfEven, RoundingPrecisionDollars, 3.5, 4},
+		{RoundingMethodHalfUp, RoundingPrecisionCents, 7529.554, 7529.55},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v/%v/%v", tt.method, tt.precision, tt.amount)
+		t.Run(testname, func(t *testing.T) {
+			policy := RoundingPolicy{Mode: RoundingModeTotal, Method: tt.method, Precision: tt.precision}
+			if ans := policy.Round(tt.amount); ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+// TestRoundingPolicyFormatRate tests that the effective tax rate is formatted with the decimals of the policy.
+func TestRoundingPolicyFormatRate(t *testing.T) {
+	if ans := (RoundingPolicy{EffectiveRateDecimals: 2}).FormatRate(0.177392); ans != "17.74%" {
+		t.Errorf("got %v, want 17.74%%", ans)
+	}
+	if ans := (RoundingPolicy{EffectiveRateDecimals: 0}).FormatRate(0.177392); ans != "18%" {
+		t.Errorf("got %v, want 18%%", ans)
+	}
+}
+
+// TestCalculateTaxRoundingMode tests that the per-line mode sums the rounded bands while the total mode rounds the total.
+func TestCalculateTaxRoundingMode(t *testing.T) {
+	var tests = []struct {
+		mode string
+		want float64
+	}{
+		// 7529.55 + 10209.615
+		{RoundingModeTotal, 17739},
+		// 7530 + 10210
+		{RoundingModePerLine, 17740},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			calc := NewCalculationContext("2022", JurisdictionCanada, 100000, TaxBrackets["2022"])
+			calc.Rounding = RoundingPolicy{Mode: tt.mode, Method: RoundingMethodHalfUp, Precision: RoundingPrecisionDollars}
+			ans, err := CalculateTax(calc)
+			if err != nil {
+				t.Fatalf("got error %v, want nil", *err)
+			}
+			if ans.TotalTaxOwed != tt.want {
+				t.Errorf("got total tax owed %v, want %v", ans.TotalTaxOwed, tt.want)
+			}
+			if ans.Rounding != calc.Rounding {
+				t.Errorf("got rounding policy %v, want %v", ans.Rounding, calc.Rounding)
+			}
+		})
+	}
+}
+
+// TestRoundingPoliciesDataset tests that the rounding policies are loaded from the dataset and validated.
+func TestRoundingPoliciesDataset(t *testing.T) {
+	if ans := GetRoundingPolicy(JurisdictionUS); ans.Precision != RoundingPrecisionDollars {
+		t.Errorf("got US precision %v, want %v", ans.Precision, RoundingPrecisionDollars)
+	}
+	if ans := GetRoundingPolicy("XX"); ans != RoundingPolicies[JurisdictionCanada] {
+		t.Errorf("got %v for an unknown jurisdiction, want the Canadian policy", ans)
+	}
+	data := `{"years": [], "rounding_policies": {"CA": {"mode": "total", "method": "half_down", "precision": "cents"}}}`
+	if _, err := LoadTaxBracketDataset([]byte(data)); err == nil {
+		t.Errorf("got no error for an unknown rounding method, want error")
+	}
+}
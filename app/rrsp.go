@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+)
+
+const (
+	// defaultRRSPIncrement is the step between the contributions of the tax savings curve.
+	defaultRRSPIncrement = 1000
+	// maxRRSPCurvePoints limits the number of contributions of the tax savings curve.
+	maxRRSPCurvePoints = 1000
+)
+
+// OptimizeRRSP calculates the tax savings curve of RRSP contributions.
+func (s *TaxService) OptimizeRRSP(ctx context.Context, request api.OptimizeRRSPRequestObject) (api.OptimizeRRSPResponseObject, error) {
+	response, err := s.optimizeRRSP(request.Year, *request.Body)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.OptimizeRRSP404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.OptimizeRRSP400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.OptimizeRRSP200JSONResponse(response), nil
+}
+
+// optimizeRRSP calculates the tax savings of contributions in increments up to the contribution room, and of the
+// contribution that brings the taxable income down to the threshold of the next lower federal bracket.
+// Contributions are deducted on top of the deductions of the request.
+func (s *TaxService) optimizeRRSP(year string, request api.RRSPRequest) (api.RRSPResponse, *Err) {
+	if request.Jurisdiction != "" && request.Jurisdiction != JurisdictionCanada {
+		return api.RRSPResponse{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "jurisdiction",
+			Message: fmt.Sprintf("RRSP contributions are only deductible in the jurisdiction '%v'. Invalid value: %v", JurisdictionCanada, request.Jurisdiction),
+		}
+	}
+	if request.ContributionRoom < 0 {
+		return api.RRSPResponse{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "contribution_room",
+			Message: fmt.Sprintf("the contribution room must be greater than 0. Invalid value: %.2f", request.ContributionRoom),
+		}
+	}
+	increment := request.Increment
+	if increment == 0 {
+		increment = defaultRRSPIncrement
+	}
+	if increment < 0 || request.ContributionRoom/increment > maxRRSPCurvePoints {
+		return api.RRSPResponse{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "increment",
+			Message: fmt.Sprintf("the increment must be greater than 0 and split the contribution room in at most %d contributions. Invalid value: %.2f", maxRRSPCurvePoints, increment),
+		}
+	}
+
+	input := api.CalculateRequest{
+		Salary:          request.Salary,
+		Jurisdiction:    request.Jurisdiction,
+		FilingStatus:    request.FilingStatus,
+		Province:        request.Province,
+		Deductions:      request.Deductions,
+		TaxWithheld:     request.TaxWithheld,
+		InstalmentsPaid: request.InstalmentsPaid,
+
+		ResidencyStartDate:   request.ResidencyStartDate,
+		ResidencyEndDate:     request.ResidencyEndDate,
+		NonResident:          request.NonResident,
+		CanadianSourceIncome: request.CanadianSourceIncome,
+		IncomeItems:          request.IncomeItems,
+	}
+	base, err := s.calculate(year, input)
+	if err != nil {
+		return api.RRSPResponse{}, err
+	}
+	contribute := func(contribution float64) (api.RRSPContribution, *Err) {
+		contributionInput := input
+		contributionInput.Deductions += contribution
+		taxOwed, err := s.calculate(year, contributionInput)
+		if err != nil {
+			return api.RRSPContribution{}, err
+		}
+		savings := roundCents(base.TotalTaxOwed - taxOwed.TotalTaxOwed)
+		var savingsRate float64
+		if contribution > 0 {
+			savingsRate = savings / contribution
+		}
+		return api.RRSPContribution{
+			Contribution:   contribution,
+			TaxableIncome:  taxOwed.TaxableIncome,
+			TotalTaxOwed:   taxOwed.TotalTaxOwed,
+			TaxSavings:     savings,
+			TaxSavingsRate: savingsRate,
+		}, nil
+	}
+
+	// Contributions above the taxable income do not save any more tax.
+	room := math.Min(request.ContributionRoom, base.TaxableIncome)
+	response := api.RRSPResponse{
+		TaxYear:          base.TaxYear,
+		TaxableIncome:    base.TaxableIncome,
+		ContributionRoom: request.ContributionRoom,
+		Increment:        increment,
+	}
+	for contribution := 0.0; ; contribution += increment {
+		contribution = math.Min(contribution, room)
+		point, err := contribute(contribution)
+		if err != nil {
+			return api.RRSPResponse{}, err
+		}
+		response.Curve = append(response.Curve, point)
+		if contribution >= room {
+			break
+		}
+	}
+
+	taxBrackets, err := GetTaxCalculatorInstructionsByYear(base.TaxYear)
+	if err != nil {
+		return api.RRSPResponse{}, err
+	}
+	if threshold, rate, ok := nextLowerBracketThreshold(taxBrackets, base.TaxableIncome); ok && base.TaxableIncome-threshold <= room {
+		point, err := contribute(roundCents(base.TaxableIncome - threshold))
+		if err != nil {
+			return api.RRSPResponse{}, err
+		}
+		response.NextLowerBracket = &api.RRSPBracketThreshold{
+			Contribution:   point.Contribution,
+			TaxableIncome:  point.TaxableIncome,
+			TotalTaxOwed:   point.TotalTaxOwed,
+			TaxSavings:     point.TaxSavings,
+			TaxSavingsRate: point.TaxSavingsRate,
+			Threshold:      threshold,
+			Rate:           rate,
+		}
+	}
+	return response, nil
+}
+
+// nextLowerBracketThreshold returns the minimum of the bracket the taxable income falls in and the rate of the bracket
+// below it. A taxable income in the lowest bracket has no lower threshold.
+func nextLowerBracketThreshold(taxBrackets []TaxBracket, taxableIncome float64) (float64, float64, bool) {
+	for i := len(taxBrackets) - 1; i > 0; i-- {
+		if taxableIncome > taxBrackets[i].Min {
+			return taxBrackets[i].Min, taxBrackets[i-1].Rate, true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"testing"
+)
+
+// TestOptimizeRRSP tests the optimizeRRSP function.
+func TestOptimizeRRSP(t *testing.T) {
+	s := NewTaxService()
+	ans, err := s.optimizeRRSP("2022", api.RRSPRequest{Salary: 60000, ContributionRoom: 20000})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	if len(ans.Curve) != 21 {
+		t.Fatalf("got %d contributions, want 21", len(ans.Curve))
+	}
+	if got := ans.Curve[5]; got.Contribution != 5000 || got.TaxSavings != 1025 || got.TaxSavingsRate != 0.205 {
+		t.Errorf("got %+v, want tax savings of 1025 at 20.5%% for a contribution of 5000", got)
+	}
+	for i := 1; i < len(ans.Curve); i++ {
+		if ans.Curve[i].TaxSavings < ans.Curve[i-1].TaxSavings {
+			t.Errorf("got tax savings %v after %v, want the tax savings to increase with the contribution", ans.Curve[i].TaxSavings, ans.Curve[i-1].TaxSavings)
+		}
+	}
+	next := ans.NextLowerBracket
+	if next == nil {
+		t.Fatal("got no next lower bracket, want the 50197 threshold")
+	}
+	if next.Threshold != 50197 || next.Contribution != 9803 || next.TaxableIncome != 50197 || next.Rate != 0.15 {
+		t.Errorf("got %+v, want a contribution of 9803 down to the 50197 threshold at 15%%", *next)
+	}
+}
+
+// TestOptimizeRRSPNextLowerBracket tests when the next lower bracket threshold is reported.
+func TestOptimizeRRSPNextLowerBracket(t *testing.T) {
+	s := NewTaxService()
+	var tests = []struct {
+		name    string
+		request api.RRSPRequest
+		want    float64
+		error   int
+	}{
+		{"within the contribution room", api.RRSPRequest{Salary: 120000, ContributionRoom: 30000}, 100392, 0},
+		{"beyond the contribution room", api.RRSPRequest{Salary: 120000, ContributionRoom: 10000}, 0, 0},
+		{"lowest bracket", api.RRSPRequest{Salary: 40000, ContributionRoom: 10000}, 0, 0},
+		{"on the threshold", api.RRSPRequest{Salary: 50197, ContributionRoom: 10000}, 0, 0},
+		{"US jurisdiction", api.RRSPRequest{Salary: 60000, ContributionRoom: 10000, Jurisdiction: JurisdictionUS}, 0, http.StatusBadRequest},
+		{"too many contributions", api.RRSPRequest{Salary: 60000, ContributionRoom: 10000, Increment: 1}, 0, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ans, err := s.optimizeRRSP("2022", tt.request)
+			if err != nil {
+				if err.Code != tt.error {
+					t.Errorf("got error %v, want code %v", *err, tt.error)
+				}
+				return
+			}
+			if tt.error != 0 {
+				t.Fatalf("got no error, want code %v", tt.error)
+			}
+			var got float64
+			if ans.NextLowerBracket != nil {
+				got = ans.NextLowerBracket.Threshold
+			}
+			if got != tt.want {
+				t.Errorf("got threshold %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
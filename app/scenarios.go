@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+)
+
+// CompareScenarios calculates the tax of every scenario and compares each one against the baseline scenario.
+func (s *TaxService) CompareScenarios(ctx context.Context, request api.CompareScenariosRequestObject) (api.CompareScenariosResponseObject, error) {
+	response, err := s.compareScenarios(*request.Body)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.CompareScenarios404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.CompareScenarios400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.CompareScenarios200JSONResponse(response), nil
+}
+
+// compareScenarios calculates the scenarios and the deltas of each one against the baseline.
+func (s *TaxService) compareScenarios(request api.CompareScenariosRequest) (api.CompareScenariosResponse, *Err) {
+	if len(request.Scenarios) < 2 {
+		return api.CompareScenariosResponse{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "scenarios",
+			Message: fmt.Sprintf("at least 2 scenarios are required to compare. Got: %d", len(request.Scenarios)),
+		}
+	}
+
+	baseline := request.Baseline
+	if baseline == "" {
+		baseline = request.Scenarios[0].Name
+	}
+
+	names := map[string]bool{}
+	results := make([]TaxOwed, len(request.Scenarios))
+	baselineIndex := -1
+	for i, scenario := range request.Scenarios {
+		if scenario.Name == "" || names[scenario.Name] {
+			return api.CompareScenariosResponse{}, &Err{
+				Code:    http.StatusBadRequest,
+				Field:   fmt.Sprintf("scenarios[%d].name", i),
+				Message: fmt.Sprintf("the scenario name '%v' must be unique and not empty", scenario.Name),
+			}
+		}
+		names[scenario.Name] = true
+		if scenario.Name == baseline {
+			baselineIndex = i
+		}
+
+		taxOwed, err := s.calculate(scenario.Year, api.CalculateRequest{
+			Salary:          scenario.Salary,
+			Jurisdiction:    scenario.Jurisdiction,
+			FilingStatus:    scenario.FilingStatus,
+			Province:        scenario.Province,
+			Deductions:      scenario.Deductions,
+			TaxWithheld:     scenario.TaxWithheld,
+			InstalmentsPaid: scenario.InstalmentsPaid,
+
+			ResidencyStartDate:   scenario.ResidencyStartDate,
+			ResidencyEndDate:     scenario.ResidencyEndDate,
+			NonResident:          scenario.NonResident,
+			CanadianSourceIncome: scenario.CanadianSourceIncome,
+			IncomeItems:          scenario.IncomeItems,
+		})
+		if err != nil {
+			err.Field = fmt.Sprintf("scenarios[%d].%v", i, err.Field)
+			return api.CompareScenariosResponse{}, err
+		}
+		results[i] = taxOwed
+	}
+
+	if baselineIndex < 0 {
+		return api.CompareScenariosResponse{}, &Err{
+			Code:    http.StatusBadRequest,
+			Field:   "baseline",
+			Message: fmt.Sprintf("the baseline scenario '%v' is not found", baseline),
+		}
+	}
+
+	response := api.CompareScenariosResponse{
+		Baseline: baseline,
+	}
+	for i, scenario := range request.Scenarios {
+		response.Scenarios = append(response.Scenarios, api.ScenarioResult{
+			Name:   scenario.Name,
+			Result: mapTaxOwedToAPICalculateResponse(results[i]),
+			Delta:  scenarioDelta(results[baselineIndex], results[i]),
+		})
+	}
+	return response, nil
+}
+
+// scenarioDelta returns the difference between the tax owed of a scenario and the baseline.
+func scenarioDelta(baseline TaxOwed, scenario TaxOwed) api.ScenarioDelta {
+	return api.ScenarioDelta{
+		TotalTaxOwed:     roundCents(scenario.TotalTaxOwed - baseline.TotalTaxOwed),
+		NetIncome:        roundCents(scenario.NetIncome - baseline.NetIncome),
+		EffectiveTaxRate: roundCents((scenario.EffectiveRate - baseline.EffectiveRate) * 100),
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"testing"
+)
+
+// TestCompareScenarios tests the compareScenarios function.
+func TestCompareScenarios(t *testing.T) {
+	s := NewTaxService()
+	response, err := s.compareScenarios(api.CompareScenariosRequest{
+		Baseline: "stay",
+		Scenarios: []api.ScenarioInput{
+			{Name: "stay", Year: "2023", Salary: 90000, Province: ProvinceOntario},
+			{Name: "move", Year: "2023", Salary: 110000, Province: ProvinceAlberta},
+		},
+	})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", *err)
+	}
+	if response.Baseline != "stay" {
+		t.Errorf("got baseline %v, want %v", response.Baseline, "stay")
+	}
+	if len(response.Scenarios) != 2 {
+		t.Fatalf("got %d scenarios, want %d", len(response.Scenarios), 2)
+	}
+	if response.Scenarios[0].Delta != (api.ScenarioDelta{}) {
+		t.Errorf("got baseline delta %v, want zero", response.Scenarios[0].Delta)
+	}
+	stay, move := response.Scenarios[0].Result, response.Scenarios[1].Result
+	delta := response.Scenarios[1].Delta
+	if want := roundCents(move.TotalTaxOwed - stay.TotalTaxOwed); delta.TotalTaxOwed != want {
+		t.Errorf("got total tax delta %v, want %v", delta.TotalTaxOwed, want)
+	}
+	if want := roundCents(move.NetIncome - stay.NetIncome); delta.NetIncome != want {
+		t.Errorf("got net income delta %v, want %v", delta.NetIncome, want)
+	}
+}
+
+// TestCompareScenariosErrors tests the validation of the scenarios.
+func TestCompareScenariosErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		request api.CompareScenariosRequest
+		error   Err
+	}{
+		{
+			"single scenario",
+			api.CompareScenariosRequest{Scenarios: []api.ScenarioInput{{Name: "a", Year: "2023", Salary: 1}}},
+			Err{Code: http.StatusBadRequest, Field: "scenarios"},
+		},
+		{
+			"duplicate name",
+			api.CompareScenariosRequest{Scenarios: []api.ScenarioInput{{Name: "a", Year: "2023"}, {Name: "a", Year: "2023"}}},
+			Err{Code: http.StatusBadRequest, Field: "scenarios[1].name"},
+		},
+		{
+			"unknown baseline",
+			api.CompareScenariosRequest{Baseline: "c", Scenarios: []api.ScenarioInput{{Name: "a", Year: "2023"}, {Name: "b", Year: "2023"}}},
+			Err{Code: http.StatusBadRequest, Field: "baseline"},
+		},
+		{
+			"unknown year",
+			api.CompareScenariosRequest{Scenarios: []api.ScenarioInput{{Name: "a", Year: "2023"}, {Name: "b", Year: "1999"}}},
+			Err{Code: http.StatusNotFound, Field: "scenarios[1].year"},
+		},
+	}
+	s := NewTaxService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.compareScenarios(tt.request)
+			if err == nil {
+				t.Fatalf("got no error, want %v", tt.error)
+			}
+			if err.Code != tt.error.Code || err.Field != tt.error.Field {
+				t.Errorf("got error %v, want code %v and field %v", *err, tt.error.Code, tt.error.Field)
+			}
+		})
+	}
+}
@@ -1,142 +1,251 @@
 package main
 
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
 // TaxBracket represents a tax bracket for a given year.
 type TaxBracket struct {
-	Min     float32 `json:"min"`
-	Max     float32 `json:"max"`
-	Rate    float32 `json:"rate"`
-	TaxOwed float32 `json:"tax_owed,omitempty"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	Rate    float64 `json:"rate"`
+	TaxOwed float64 `json:"tax_owed,omitempty"`
+}
+
+// Provenance represents where the tax brackets of a year come from.
+type Provenance struct {
+	// Source is the name of the authoritative source document and SourceURL is where to find it.
+	Source    string `json:"source"`
+	SourceURL string `json:"source_url"`
+	// PublicationDate is when the source was published, and LastVerified when the brackets were last checked
+	// against it, formatted as dates, e.g. 2006-01-02.
+	PublicationDate string `json:"publication_date,omitempty"`
+	LastVerified    string `json:"last_verified,omitempty"`
+	Notes           string `json:"notes,omitempty"`
+}
+
+// TaxYear represents the federal tax brackets of a tax year and their provenance.
+type TaxYear struct {
+	Year       string       `json:"year"`
+	Brackets   []TaxBracket `json:"brackets"`
+	Provenance Provenance   `json:"provenance"`
+}
+
+// TaxBracketDataset represents the federal tax brackets of all supported years, sorted by year, the rounding
+// policy of every jurisdiction, and the tax rule pipelines by jurisdiction and year.
+type TaxBracketDataset struct {
+	// LastModified is when the dataset was last written, formatted as a timestamp, e.g. 2006-01-02T15:04:05Z.
+	LastModified     string                                    `json:"last_modified,omitempty"`
+	Years            []TaxYear                                 `json:"years"`
+	RoundingPolicies map[string]RoundingPolicy                 `json:"rounding_policies,omitempty"`
+	TaxRulePipelines map[string]map[string][]TaxRuleDefinition `json:"tax_rule_pipelines,omitempty"`
 }
 
+//go:embed data/tax_brackets.json
+var taxBracketsJSON []byte
+
+// FederalTaxYears represents the embedded federal tax bracket dataset, written by the import command.
+var FederalTaxYears = mustLoadTaxBracketDataset(taxBracketsJSON)
+
+// DatasetVersion is the hash of the data the calculations depend on: the embedded data files, with the federal brackets,
+// the rounding policies, the tax rule pipelines and the exchange rates, and the brackets coded in Go. It changes
+// whenever any of them does.
+var DatasetVersion = datasetVersion(taxBracketsJSON, []byte(exchangeRatesCSV), codedTaxData())
+
 // TaxBrackets represents the tax brackets for all supported years.
 // Use Singleton pattern
-var TaxBrackets = map[string][]TaxBracket{
-	"2019": {
-		{Min: 0,
-			Max:  47630,
-			Rate: 0.15,
-		},
-		{Min: 47630,
-			Max:  95259,
-			Rate: 0.205,
-		},
-		{
-			Min:  95259,
-			Max:  147667,
-			Rate: 0.26,
-		},
-		{
-			Min:  147667,
-			Max:  210371,
-			Rate: 0.29,
-		},
-		{
-			Min:  210371,
-			Rate: 0.33,
-		},
-	},
-	"2020": {
-		{
-			Min:  0,
-			Max:  48535,
-			Rate: 0.15,
-		},
-		{
-			Min:  48535,
-			Max:  97069,
-			Rate: 0.205,
-		},
-		{
-			Min:  97069,
-			Max:  150473,
-			Rate: 0.26,
-		},
-		{
-			Min:  150473,
-			Max:  214368,
-			Rate: 0.29,
-		},
-		{
-			Min:  214368,
-			Rate: 0.33,
-		},
-	},
-	"2021": {
-		{
-			Min:  0,
-			Max:  49020,
-			Rate: 0.15,
-		},
-		{
-			Min:  49020,
-			Max:  98040,
-			Rate: 0.205,
-		},
-		{
-			Min:  98040,
-			Max:  151978,
-			Rate: 0.26,
-		},
-		{
-			Min:  151978,
-			Max:  216511,
-			Rate: 0.29,
-		},
-		{
-			Min:  216511,
-			Rate: 0.33,
-		},
-	},
-	"2022": {
-		{
-			Min:  0,
-			Max:  50197,
-			Rate: 0.15,
-		},
-		{
-			Min:  50197,
-			Max:  100392,
-			Rate: 0.205,
-		},
-		{
-			Min:  100392,
-			Max:  155625,
-			Rate: 0.26,
-		},
-		{
-			Min:  155625,
-			Max:  221708,
-			Rate: 0.29,
-		},
-		{
-			Min:  221708,
-			Rate: 0.33,
-		},
-	},
-	"2023": {
-		{
-			Min:  0,
-			Max:  53359,
-			Rate: 0.15,
-		},
-		{
-			Min:  53359,
-			Max:  106717,
-			Rate: 0.205,
-		},
-		{
-			Min:  106717,
-			Max:  165430,
-			Rate: 0.26,
-		},
-		{
-			Min:  165430,
-			Max:  235675,
-			Rate: 0.29,
-		},
-		{
-			Min:  235675,
-			Rate: 0.33,
-		},
-	},
+var TaxBrackets = FederalTaxYears.Brackets()
+
+// LoadTaxBracketDataset loads and validates a tax bracket dataset.
+func LoadTaxBracketDataset(data []byte) (TaxBracketDataset, error) {
+	var dataset TaxBracketDataset
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		return TaxBracketDataset{}, fmt.Errorf("error reading the tax bracket dataset: %w", err)
+	}
+	years := map[string]bool{}
+	for _, taxYear := range dataset.Years {
+		if years[taxYear.Year] {
+			return TaxBracketDataset{}, fmt.Errorf("error reading the tax bracket dataset: the tax year '%v' is duplicated", taxYear.Year)
+		}
+		years[taxYear.Year] = true
+		if err := ValidateTaxYear(taxYear); err != nil {
+			return TaxBracketDataset{}, err
+		}
+	}
+	if _, err := time.Parse(time.RFC3339, dataset.LastModified); dataset.LastModified != "" && err != nil {
+		return TaxBracketDataset{}, fmt.Errorf("error reading the tax bracket dataset: the last modification '%v' is not formatted as 2006-01-02T15:04:05Z", dataset.LastModified)
+	}
+	for jurisdiction, policy := range dataset.RoundingPolicies {
+		if err := ValidateRoundingPolicy(jurisdiction, policy); err != nil {
+			return TaxBracketDataset{}, err
+		}
+	}
+	if _, err := dataset.TaxRules(); err != nil {
+		return TaxBracketDataset{}, fmt.Errorf("error reading the tax bracket dataset: %w", err)
+	}
+	return dataset, nil
+}
+
+// mustLoadTaxBracketDataset loads the embedded tax bracket dataset and panics if it is invalid, has no last
+// modification, or has no Canadian rounding policy, which is the default one.
+func mustLoadTaxBracketDataset(data []byte) TaxBracketDataset {
+	dataset, err := LoadTaxBracketDataset(data)
+	if err != nil {
+		panic(err)
+	}
+	if dataset.LastModified == "" {
+		panic(fmt.Errorf("error reading the tax bracket dataset: no last modification"))
+	}
+	if _, ok := dataset.RoundingPolicies[JurisdictionCanada]; !ok {
+		panic(fmt.Errorf("error reading the tax bracket dataset: no rounding policy for the jurisdiction '%v'", JurisdictionCanada))
+	}
+	return dataset
+}
+
+// ValidateTaxYear validates the tax brackets of a year: they start at 0, every bracket starts where the previous one
+// ends, the last one is open-ended, the rates are between 0 and 1, and the provenance has a source.
+func ValidateTaxYear(taxYear TaxYear) error {
+	if err := ValidateYear(taxYear.Year); err != nil {
+		return fmt.Errorf("invalid tax year '%v': %v", taxYear.Year, err.Message)
+	}
+	if len(taxYear.Brackets) == 0 {
+		return fmt.Errorf("invalid tax year '%v': no tax brackets", taxYear.Year)
+	}
+	var min float64
+	for i, bracket := range taxYear.Brackets {
+		if bracket.Min != min {
+			return fmt.Errorf("invalid tax year '%v': bracket %d starts at %v, want %v", taxYear.Year, i, bracket.Min, min)
+		}
+		if bracket.Rate <= 0 || bracket.Rate >= 1 {
+			return fmt.Errorf("invalid tax year '%v': bracket %d has the rate %v, want a rate between 0 and 1", taxYear.Year, i, bracket.Rate)
+		}
+		last := i == len(taxYear.Brackets)-1
+		if last && bracket.Max != 0 {
+			return fmt.Errorf("invalid tax year '%v': the last bracket ends at %v, want it open-ended", taxYear.Year, bracket.Max)
+		}
+		if !last && bracket.Max <= bracket.Min {
+			return fmt.Errorf("invalid tax year '%v': bracket %d ends at %v, want more than %v", taxYear.Year, i, bracket.Max, bracket.Min)
+		}
+		min = bracket.Max
+	}
+	return ValidateProvenance(taxYear.Year, taxYear.Provenance)
+}
+
+// ValidateProvenance validates the provenance of a tax year: it has a source with an absolute URL, and its
+// dates, if any, are valid.
+func ValidateProvenance(year string, provenance Provenance) error {
+	if provenance.Source == "" {
+		return fmt.Errorf("invalid tax year '%v': the provenance has no source", year)
+	}
+	if u, err := url.Parse(provenance.SourceURL); err != nil || !u.IsAbs() {
+		return fmt.Errorf("invalid tax year '%v': the source URL '%v' is not an absolute URL", year, provenance.SourceURL)
+	}
+	for _, date := range []string{provenance.PublicationDate, provenance.LastVerified} {
+		if _, err := time.Parse(time.DateOnly, date); date != "" && err != nil {
+			return fmt.Errorf("invalid tax year '%v': the date '%v' is not formatted as 2006-01-02", year, date)
+		}
+	}
+	return nil
+}
+
+// GetTaxYearProvenance returns the provenance of the federal tax brackets of the year.
+func GetTaxYearProvenance(year string) (Provenance, *Err) {
+	taxYear, err := FederalTaxYears.Year(year)
+	if err != nil {
+		return Provenance{}, err
+	}
+	return taxYear.Provenance, nil
+}
+
+// Year returns the tax year of the dataset.
+func (d TaxBracketDataset) Year(year string) (TaxYear, *Err) {
+	for _, taxYear := range d.Years {
+		if taxYear.Year == year {
+			return taxYear, nil
+		}
+	}
+	return TaxYear{}, &Err{
+		Code:    http.StatusNotFound,
+		Field:   "year",
+		Message: fmt.Sprintf("tax brackets for the tax year '%v' is not found", year),
+	}
+}
+
+// datasetVersion returns a short hash of the data files.
+func datasetVersion(files ...[]byte) string {
+	hash := sha256.New()
+	for _, file := range files {
+		hash.Write(file)
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// codedTaxData returns the tax data coded in Go as JSON, so it can be hashed with the data files: the US and provincial
+// brackets, the provincial tax authorities and the currencies of the jurisdictions.
+func codedTaxData() []byte {
+	data, err := json.Marshal(struct {
+		USFederalTaxBrackets     map[string]map[string]FilingStatusBrackets
+		ProvincialTaxBrackets    map[string]map[string][]TaxBracket
+		ProvincialTaxAuthorities map[string]string
+		JurisdictionCurrencies   map[string]string
+	}{USFederalTaxBrackets, ProvincialTaxBrackets, ProvincialTaxAuthorities, JurisdictionCurrencies})
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Brackets returns the tax brackets of the dataset by year.
+func (d TaxBracketDataset) Brackets() map[string][]TaxBracket {
+	taxBrackets := make(map[string][]TaxBracket, len(d.Years))
+	for _, taxYear := range d.Years {
+		taxBrackets[taxYear.Year] = taxYear.Brackets
+	}
+	return taxBrackets
+}
+
+// Merge returns the dataset with the tax years added, replacing the years already in the dataset.
+func (d TaxBracketDataset) Merge(taxYears []TaxYear) TaxBracketDataset {
+	byYear := map[string]TaxYear{}
+	for _, taxYear := range d.Years {
+		byYear[taxYear.Year] = taxYear
+	}
+	for _, taxYear := range taxYears {
+		byYear[taxYear.Year] = taxYear
+	}
+	merged := TaxBracketDataset{
+		LastModified:     d.LastModified,
+		Years:            make([]TaxYear, 0, len(byYear)),
+		RoundingPolicies: d.RoundingPolicies,
+		TaxRulePipelines: d.TaxRulePipelines,
+	}
+	for _, taxYear := range byYear {
+		merged.Years = append(merged.Years, taxYear)
+	}
+	sort.Slice(merged.Years, func(i, j int) bool {
+		return merged.Years[i].Year < merged.Years[j].Year
+	})
+	return merged
+}
+
+// bracketsFromThresholds builds the tax brackets from the marginal rates and the upper limit of every bracket but the last.
+func bracketsFromThresholds(rates []float64, thresholds ...float64) []TaxBracket {
+	brackets := make([]TaxBracket, len(rates))
+	var min float64
+	for i, rate := range rates {
+		brackets[i] = TaxBracket{Min: min, Rate: rate}
+		if i < len(thresholds) {
+			brackets[i].Max = thresholds[i]
+			min = thresholds[i]
+		}
+	}
+	return brackets
 }
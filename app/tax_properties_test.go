@@ -0,0 +1,111 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// maxFuzzSalary bounds the fuzzed salaries, beyond which float64 cannot hold whole dollars.
+const maxFuzzSalary = 1e9
+
+// sortedTaxYears returns the years of the tax brackets in order.
+func sortedTaxYears() []string {
+	years := make([]string, 0, len(TaxBrackets))
+	for year := range TaxBrackets {
+		years = append(years, year)
+	}
+	sort.Strings(years)
+	return years
+}
+
+// checkTaxInvariants checks the invariants of CalculateTaxAmount for the salary of the year: the tax never exceeds
+// the salary at the top rate, the bands sum to the total, and the effective rate is a finite number.
+func checkTaxInvariants(t *testing.T, year string, salary float64) TaxOwed {
+	t.Helper()
+	taxBrackets := TaxBrackets[year]
+	taxOwed := CalculateTaxAmount(year, taxBrackets, salary)
+
+	// The totals are rounded to the cent and float64 holds about 7 significant digits.
+	tolerance := 0.005 + float64(salary)*1e-6
+
+	var topRate float64
+	for _, bracket := range taxBrackets {
+		topRate = float64(math.Max(float64(topRate), float64(bracket.Rate)))
+	}
+	if float64(taxOwed.TotalTaxOwed) > float64(salary*topRate)+tolerance {
+		t.Errorf("%v/%v: got total tax owed %v, want at most %v at the top rate", year, salary, taxOwed.TotalTaxOwed, salary*topRate)
+	}
+	if taxOwed.TotalTaxOwed < 0 {
+		t.Errorf("%v/%v: got total tax owed %v, want at least 0", year, salary, taxOwed.TotalTaxOwed)
+	}
+
+	// Every band is rounded to the cent on its own.
+	var bandSum float64
+	for _, band := range taxOwed.TaxOwedPerBand {
+		bandSum += float64(band.TaxOwed)
+	}
+	if diff := math.Abs(bandSum - float64(taxOwed.TotalTaxOwed)); diff > tolerance+0.005*float64(len(taxOwed.TaxOwedPerBand)) {
+		t.Errorf("%v/%v: got bands summing to %v, want the total tax owed %v", year, salary, bandSum, taxOwed.TotalTaxOwed)
+	}
+
+	rate, err := strconv.ParseFloat(strings.TrimSuffix(taxOwed.EffectiveTaxRate, "%"), 64)
+	if err != nil || math.IsNaN(rate) || math.IsInf(rate, 0) || rate < 0 || rate > float64(topRate)*100+0.01 {
+		t.Errorf("%v/%v: got effective tax rate %v, want a finite rate between 0 and the top rate", year, salary, taxOwed.EffectiveTaxRate)
+	}
+	return taxOwed
+}
+
+// TestCalculateTaxAmountProperties checks the invariants of CalculateTaxAmount on every threshold of every year,
+// around them, and for salaries of 0 and huge salaries, and that the tax is monotonic in the salary.
+func TestCalculateTaxAmountProperties(t *testing.T) {
+	for _, year := range sortedTaxYears() {
+		salaries := []float64{0, 0.01, 1, maxFuzzSalary}
+		for _, bracket := range TaxBrackets[year] {
+			for _, threshold := range []float64{bracket.Min, bracket.Max} {
+				if threshold > 0 {
+					salaries = append(salaries, threshold-0.01, threshold, threshold+0.01)
+				}
+			}
+		}
+		for salary := float64(0); salary <= 500000; salary += 997 {
+			salaries = append(salaries, salary)
+		}
+		sort.Slice(salaries, func(i, j int) bool { return salaries[i] < salaries[j] })
+
+		var previous TaxOwed
+		for i, salary := range salaries {
+			taxOwed := checkTaxInvariants(t, year, salary)
+			if i > 0 && taxOwed.TotalTaxOwed < previous.TotalTaxOwed {
+				t.Errorf("%v: got total tax owed %v for %v, less than %v for %v", year, taxOwed.TotalTaxOwed, salary, previous.TotalTaxOwed, previous.Salary)
+			}
+			previous = taxOwed
+		}
+	}
+}
+
+// FuzzCalculateTaxAmount checks the invariants of CalculateTaxAmount and that the tax is monotonic in the salary:
+//
+//	go test ./app -run '^$' -fuzz FuzzCalculateTaxAmount -fuzztime 30s
+func FuzzCalculateTaxAmount(f *testing.F) {
+	f.Add(uint8(0), float64(0), float64(0))
+	f.Add(uint8(22), float64(50197), float64(0.01))
+	f.Add(uint8(22), float64(1234567), float64(1))
+	f.Add(uint8(23), float64(235675), float64(100))
+	f.Add(uint8(6), float64(36378), float64(1000))
+	years := sortedTaxYears()
+	f.Fuzz(func(t *testing.T, yearIndex uint8, salary float64, raise float64) {
+		salary, raise = float64(math.Abs(float64(salary))), float64(math.Abs(float64(raise)))
+		if math.IsNaN(float64(salary+raise)) || salary+raise > maxFuzzSalary {
+			t.Skip()
+		}
+		year := years[int(yearIndex)%len(years)]
+		taxOwed := checkTaxInvariants(t, year, salary)
+		raised := checkTaxInvariants(t, year, salary+raise)
+		if raised.TotalTaxOwed < taxOwed.TotalTaxOwed {
+			t.Errorf("%v: got total tax owed %v for %v, less than %v for %v", year, raised.TotalTaxOwed, salary+raise, taxOwed.TotalTaxOwed, salary)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package main
+
+import "fmt"
+
+// TaxRulePipelines represents the ordered rules applied to a calculation, per jurisdiction and year, built from the
+// pipelines of the embedded dataset. Provincial jurisdictions run after the federal jurisdiction when the calculation
+// has a province.
+var TaxRulePipelines = mustBuildTaxRulePipelines(FederalTaxYears)
+
+// Kinds of the rules of the tax rule pipelines of the dataset.
+const (
+	TaxRuleKindBrackets          = "brackets"
+	TaxRuleKindStandardDeduction = "standard_deduction"
+	TaxRuleKindCredit            = "credit"
+	TaxRuleKindAbatement         = "abatement"
+	TaxRuleKindSurtax            = "surtax"
+	TaxRuleKindClawback          = "clawback"
+	TaxRuleKindContribution      = "contribution"
+)
+
+// TaxRuleDefinition represents a rule of a tax rule pipeline in the dataset: its kind, the name of the credit,
+// abatement, surtax, clawback or contribution it calculates, and the parameters of its kind.
+type TaxRuleDefinition struct {
+	Rule             string  `json:"rule"`
+	Name             string  `json:"name,omitempty"`
+	Amount           float64 `json:"amount,omitempty"`
+	Rate             float64 `json:"rate,omitempty"`
+	Threshold        float64 `json:"threshold,omitempty"`
+	Exemption        float64 `json:"exemption,omitempty"`
+	MaxEarnings      float64 `json:"max_earnings,omitempty"`
+	MaxAmount        float64 `json:"max_amount,omitempty"`
+	Refundable       bool    `json:"refundable,omitempty"`
+	RequiresProvince bool    `json:"requires_province,omitempty"`
+}
+
+// TaxRule returns the rule of the definition, or an error if its kind is unknown or its parameters are invalid.
+func (d TaxRuleDefinition) TaxRule() (TaxRule, error) {
+	if d.Rule != TaxRuleKindBrackets && d.Rule != TaxRuleKindStandardDeduction {
+		if d.Name == "" {
+			return nil, fmt.Errorf("the %v rule has no name", d.Rule)
+		}
+		if d.Rate <= 0 || d.Rate >= 1 {
+			return nil, fmt.Errorf("the %v rule '%v' has the rate %v, want a rate between 0 and 1", d.Rule, d.Name, d.Rate)
+		}
+	}
+	switch d.Rule {
+	case TaxRuleKindBrackets:
+		return BracketTaxRule{}, nil
+	case TaxRuleKindStandardDeduction:
+		return StandardDeductionRule{}, nil
+	case TaxRuleKindCredit:
+		return CreditRule{Credit: d.Name, Amount: d.Amount, Rate: d.Rate, Refundable: d.Refundable, RequiresProvince: d.RequiresProvince}, nil
+	case TaxRuleKindAbatement:
+		return AbatementRule{Abatement: d.Name, Rate: d.Rate}, nil
+	case TaxRuleKindSurtax:
+		return SurtaxRule{Surtax: d.Name, Threshold: d.Threshold, Rate: d.Rate}, nil
+	case TaxRuleKindClawback:
+		return ClawbackRule{Clawback: d.Name, Threshold: d.Threshold, Rate: d.Rate, MaxAmount: d.MaxAmount}, nil
+	case TaxRuleKindContribution:
+		return ContributionRule{Contribution: d.Name, Rate: d.Rate, Exemption: d.Exemption, MaxEarnings: d.MaxEarnings}, nil
+	}
+	return nil, fmt.Errorf("the rule '%v' is not a known rule", d.Rule)
+}
+
+// TaxRules returns the tax rule pipelines of the dataset. The federal pipeline of Canada applies only the brackets for
+// the years of the dataset without a pipeline.
+func (d TaxBracketDataset) TaxRules() (map[string]map[string][]TaxRule, error) {
+	pipelines := map[string]map[string][]TaxRule{
+		JurisdictionCanada: bracketTaxPipelines(d.Brackets()),
+	}
+	for jurisdiction, years := range d.TaxRulePipelines {
+		if pipelines[jurisdiction] == nil {
+			pipelines[jurisdiction] = make(map[string][]TaxRule, len(years))
+		}
+		for year, definitions := range years {
+			if len(definitions) == 0 {
+				return nil, fmt.Errorf("invalid tax rule pipeline of '%v' for the tax year '%v': no rules", jurisdiction, year)
+			}
+			rules := make([]TaxRule, len(definitions))
+			for i, definition := range definitions {
+				rule, err := definition.TaxRule()
+				if err != nil {
+					return nil, fmt.Errorf("invalid tax rule pipeline of '%v' for the tax year '%v': %w", jurisdiction, year, err)
+				}
+				rules[i] = rule
+			}
+			pipelines[jurisdiction][year] = rules
+		}
+	}
+	return pipelines, nil
+}
+
+// mustBuildTaxRulePipelines returns the tax rule pipelines of the embedded dataset and panics if they are invalid.
+func mustBuildTaxRulePipelines(dataset TaxBracketDataset) map[string]map[string][]TaxRule {
+	pipelines, err := dataset.TaxRules()
+	if err != nil {
+		panic(err)
+	}
+	return pipelines
+}
+
+// bracketTaxPipelines returns a pipeline applying only the brackets for every year of the tax brackets.
+func bracketTaxPipelines(taxBrackets map[string][]TaxBracket) map[string][]TaxRule {
+	pipelines := make(map[string][]TaxRule, len(taxBrackets))
+	for year := range taxBrackets {
+		pipelines[year] = []TaxRule{BracketTaxRule{}}
+	}
+	return pipelines
+}
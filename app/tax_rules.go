@@ -0,0 +1,383 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+)
+
+// TaxRule is a single step of a tax calculation pipeline.
+// Each rule reads and updates the shared CalculationContext.
+type TaxRule interface {
+	Name() string
+	Apply(calc *CalculationContext) *Err
+}
+
+// Adjustment represents an amount added or subtracted by a rule, e.g. a credit or a contribution.
+type Adjustment struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// TaxLevel represents the tax calculated for one level of government, e.g. federal or provincial.
+type TaxLevel struct {
+	Jurisdiction string
+	// Brackets are the brackets applied by the BracketTaxRule.
+	Brackets       []TaxBracket
+	TaxOwedPerBand []TaxBracket
+	// BracketTax is the tax calculated from the brackets, before credits, surtaxes and clawbacks.
+	BracketTax float64
+	// Surtax is the sum of the surtaxes added to the tax.
+	Surtax float64
+	// Tax is the running total of the tax owed to this level of government.
+	Tax float64
+}
+
+// CalculationContext represents the state of a tax calculation shared by the rules of a pipeline.
+type CalculationContext struct {
+	Year         string
+	Jurisdiction string
+	FilingStatus string
+	Province     string
+	// Rounding is the rounding policy of the jurisdiction.
+	Rounding RoundingPolicy
+
+	// Salary is the employment income of the calculation.
+	Salary float64
+	// IncomeItems are the other income items, converted to the currency of the jurisdiction.
+	IncomeItems []IncomeItem
+	// Deductions are subtracted from the salary to get the taxable income.
+	Deductions float64
+	// StandardDeduction is the deduction subtracted by the StandardDeductionRule.
+	StandardDeduction float64
+	// TaxableIncome is the income the brackets are applied to.
+	TaxableIncome float64
+	// ContributionEarnings are the earnings payroll contributions are calculated on. It starts at the salary.
+	ContributionEarnings float64
+	// CreditProrationFactor prorates the non-refundable credits, e.g. for part-year residents. It starts at 1.
+	CreditProrationFactor float64
+	// Residency is set for part-year residents and non-residents.
+	Residency *Residency
+	// TaxWithheld and InstalmentsPaid are the tax already paid, settled against the total tax.
+	TaxWithheld     float64
+	InstalmentsPaid float64
+
+	Federal    TaxLevel
+	Provincial TaxLevel
+	// Level is the level of government the running pipeline calculates the tax for.
+	Level *TaxLevel
+
+	Credits       []Adjustment
+	Surtaxes      []Adjustment
+	Clawbacks     []Adjustment
+	Contributions []Adjustment
+}
+
+// NewCalculationContext returns a new calculation context for the salary, starting at the federal level.
+func NewCalculationContext(year string, jurisdiction string, salary float64, taxBrackets []TaxBracket) *CalculationContext {
+	calc := &CalculationContext{
+		Year:                  year,
+		Jurisdiction:          jurisdiction,
+		Rounding:              GetRoundingPolicy(jurisdiction),
+		Salary:                salary,
+		TaxableIncome:         salary,
+		ContributionEarnings:  salary,
+		CreditProrationFactor: 1,
+		Federal: TaxLevel{
+			Jurisdiction: jurisdiction,
+			Brackets:     taxBrackets,
+		},
+	}
+	calc.Level = &calc.Federal
+	return calc
+}
+
+// RunTaxRules applies the rules to the current level of the calculation context in order.
+func RunTaxRules(calc *CalculationContext, rules []TaxRule) *Err {
+	for _, rule := range rules {
+		if err := rule.Apply(calc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TotalTax returns the tax owed to all levels of government.
+func (calc *CalculationContext) TotalTax() float64 {
+	return calc.Federal.Tax + calc.Provincial.Tax
+}
+
+// TotalIncome returns the salary plus the converted income items.
+func (calc *CalculationContext) TotalIncome() float64 {
+	total := calc.Salary
+	for _, item := range calc.IncomeItems {
+		total += item.ConvertedAmount
+	}
+	return total
+}
+
+// TotalContributions returns the sum of the payroll contributions.
+func (calc *CalculationContext) TotalContributions() float64 {
+	var total float64
+	for _, contribution := range calc.Contributions {
+		total += contribution.Amount
+	}
+	return total
+}
+
+// TaxOwed returns the result of the calculation.
+func (calc *CalculationContext) TaxOwed() TaxOwed {
+	totalTax := calc.TotalTax()
+	totalIncome := calc.TotalIncome()
+	var effectiveRate float64
+	if totalIncome > 0 {
+		effectiveRate = totalTax / totalIncome
+	}
+	var refund, balanceOwing float64
+	rounding := calc.Rounding
+	// The tax already paid and the net income are calculated from the rounded totals, so they add up with them.
+	totalTaxOwed := rounding.Round(totalTax)
+	totalContributions := rounding.Round(calc.TotalContributions())
+	if settlement := rounding.Round(calc.TaxWithheld + calc.InstalmentsPaid - totalTaxOwed); settlement > 0 {
+		refund = settlement
+	} else if settlement < 0 {
+		balanceOwing = -settlement
+	}
+	return TaxOwed{
+		EffectiveTaxRate:         rounding.FormatRate(effectiveRate),
+		EffectiveRate:            rounding.RoundRate(effectiveRate),
+		MarginalTaxRate:          marginalRate(calc.Federal.Brackets, calc.TaxableIncome) + marginalRate(calc.Provincial.Brackets, calc.TaxableIncome),
+		Salary:                   calc.Salary,
+		TaxYear:                  calc.Year,
+		TaxOwedPerBand:           calc.Federal.TaxOwedPerBand,
+		TotalTaxOwed:             totalTaxOwed,
+		Jurisdiction:             calc.Jurisdiction,
+		FilingStatus:             calc.FilingStatus,
+		StandardDeduction:        calc.StandardDeduction,
+		TaxableIncome:            calc.TaxableIncome,
+		Province:                 calc.Province,
+		Deductions:               calc.Deductions,
+		FederalTaxOwed:           rounding.Round(calc.Federal.Tax),
+		ProvincialTaxOwed:        rounding.Round(calc.Provincial.Tax),
+		ProvincialTaxOwedPerBand: calc.Provincial.TaxOwedPerBand,
+		NetIncome:                rounding.Round(totalIncome - totalTaxOwed - totalContributions),
+		TotalIncome:              totalIncome,
+		IncomeItems:              calc.IncomeItems,
+		TaxWithheld:              calc.TaxWithheld,
+		InstalmentsPaid:          calc.InstalmentsPaid,
+		Refund:                   refund,
+		BalanceOwing:             balanceOwing,
+		Residency:                calc.Residency,
+		Rounding:                 rounding,
+		Credits:                  calc.Credits,
+		Surtaxes:                 calc.Surtaxes,
+		Clawbacks:                calc.Clawbacks,
+		Contributions:            calc.Contributions,
+		TotalContributions:       totalContributions,
+	}
+}
+
+// marginalRate returns the rate of the bracket the next dollar of the income falls in, or 0 without brackets.
+func marginalRate(brackets []TaxBracket, income float64) float64 {
+	var rate float64
+	for _, bracket := range brackets {
+		if income >= bracket.Min {
+			rate = bracket.Rate
+		}
+	}
+	return rate
+}
+
+// BracketTaxRule applies the progressive tax brackets to the taxable income.
+type BracketTaxRule struct{}
+
+// Name returns the name of the rule.
+func (r BracketTaxRule) Name() string {
+	return "bracket_tax"
+}
+
+// Apply calculates the tax owed per band and adds it to the tax of the current level.
+func (r BracketTaxRule) Apply(calc *CalculationContext) *Err {
+	level := calc.Level
+	if len(level.Brackets) == 0 {
+		return &Err{
+			Code:    http.StatusNotFound,
+			Field:   "year",
+			Message: fmt.Sprintf("tax brackets for the tax year '%v' is not found", calc.Year),
+		}
+	}
+	income := calc.TaxableIncome
+	var bracketTax float64
+	for _, bracket := range level.Brackets {
+		if income > bracket.Min {
+			leftover := income
+			if bracket.Max > 0 && income > bracket.Max {
+				leftover = math.Min(income, bracket.Max)
+			}
+			taxableIncome := leftover - bracket.Min
+			taxAmount := taxableIncome * bracket.Rate
+			bracketTax += calc.Rounding.RoundLine(taxAmount)
+			bracket.TaxOwed = calc.Rounding.Round(taxAmount)
+
+			level.TaxOwedPerBand = append(level.TaxOwedPerBand, bracket)
+		}
+	}
+	level.BracketTax += bracketTax
+	level.Tax += bracketTax
+	return nil
+}
+
+// StandardDeductionRule subtracts the standard deduction from the taxable income.
+type StandardDeductionRule struct{}
+
+// Name returns the name of the rule.
+func (r StandardDeductionRule) Name() string {
+	return "standard_deduction"
+}
+
+// Apply subtracts the standard deduction, never reducing the taxable income below 0.
+func (r StandardDeductionRule) Apply(calc *CalculationContext) *Err {
+	calc.TaxableIncome = math.Max(calc.TaxableIncome-calc.StandardDeduction, 0)
+	return nil
+}
+
+// CreditRule subtracts a tax credit worth Amount x Rate from the tax of the current level. A non-refundable
+// credit is prorated by the credit proration factor of the calculation and never reduces the tax below 0.
+// A credit that RequiresProvince is only claimed by the calculations with a province, which calculate the personal
+// tax with its credits; the calculations without a province are bracket-only.
+type CreditRule struct {
+	Credit           string
+	Amount           float64
+	Rate             float64
+	Refundable       bool
+	RequiresProvince bool
+}
+
+// Name returns the name of the rule.
+func (r CreditRule) Name() string {
+	return r.Credit
+}
+
+// Apply subtracts the credit from the tax of its level.
+func (r CreditRule) Apply(calc *CalculationContext) *Err {
+	if r.RequiresProvince && calc.Province == "" {
+		return nil
+	}
+	level := calc.Level
+	credit := r.Amount * r.Rate
+	if !r.Refundable {
+		credit *= calc.CreditProrationFactor
+		credit = math.Min(credit, math.Max(level.Tax, 0))
+	}
+	credit = calc.Rounding.RoundLine(credit)
+	level.Tax -= credit
+	calc.Credits = append(calc.Credits, Adjustment{Name: r.Credit, Amount: calc.Rounding.Round(credit)})
+	return nil
+}
+
+// AbatementRule subtracts Rate of the basic federal tax from the federal tax, as a refundable credit.
+// It runs in a provincial pipeline, as the abatement depends on the province of residence, after the
+// federal non-refundable credits.
+type AbatementRule struct {
+	Abatement string
+	Rate      float64
+}
+
+// Name returns the name of the rule.
+func (r AbatementRule) Name() string {
+	return r.Abatement
+}
+
+// Apply subtracts the abatement from the federal tax.
+func (r AbatementRule) Apply(calc *CalculationContext) *Err {
+	abatement := calc.Rounding.RoundLine(calc.Federal.Tax * r.Rate)
+	calc.Federal.Tax -= abatement
+	calc.Credits = append(calc.Credits, Adjustment{Name: r.Abatement, Amount: calc.Rounding.Round(abatement)})
+	return nil
+}
+
+// SurtaxRule adds a surtax of Rate on the tax of the current level above Threshold. The tax is taken after the rules
+// before it, e.g. the non-refundable credits, but before any other surtax, so several surtaxes can apply to the same
+// tax with their own thresholds.
+type SurtaxRule struct {
+	Surtax    string
+	Threshold float64
+	Rate      float64
+}
+
+// Name returns the name of the rule.
+func (r SurtaxRule) Name() string {
+	return r.Surtax
+}
+
+// Apply adds the surtax to the tax of the current level.
+func (r SurtaxRule) Apply(calc *CalculationContext) *Err {
+	tax := calc.Level.Tax - calc.Level.Surtax
+	if tax <= r.Threshold {
+		return nil
+	}
+	surtax := calc.Rounding.RoundLine((tax - r.Threshold) * r.Rate)
+	calc.Level.Tax += surtax
+	calc.Level.Surtax += surtax
+	calc.Surtaxes = append(calc.Surtaxes, Adjustment{Name: r.Surtax, Amount: calc.Rounding.Round(surtax)})
+	return nil
+}
+
+// ClawbackRule adds back Rate of the taxable income above Threshold, up to MaxAmount, to the tax of the current level.
+// A MaxAmount of 0 means the clawback is not capped.
+type ClawbackRule struct {
+	Clawback  string
+	Threshold float64
+	Rate      float64
+	MaxAmount float64
+}
+
+// Name returns the name of the rule.
+func (r ClawbackRule) Name() string {
+	return r.Clawback
+}
+
+// Apply adds the clawback to the tax of the current level.
+func (r ClawbackRule) Apply(calc *CalculationContext) *Err {
+	if calc.TaxableIncome <= r.Threshold {
+		return nil
+	}
+	clawback := (calc.TaxableIncome - r.Threshold) * r.Rate
+	if r.MaxAmount > 0 && clawback > r.MaxAmount {
+		clawback = r.MaxAmount
+	}
+	clawback = calc.Rounding.RoundLine(clawback)
+	calc.Level.Tax += clawback
+	calc.Clawbacks = append(calc.Clawbacks, Adjustment{Name: r.Clawback, Amount: calc.Rounding.Round(clawback)})
+	return nil
+}
+
+// ContributionRule calculates a payroll contribution of Rate on the contribution earnings between Exemption and MaxEarnings.
+// Contributions are withheld from the salary but are not part of the total tax.
+type ContributionRule struct {
+	Contribution string
+	Rate         float64
+	Exemption    float64
+	MaxEarnings  float64
+}
+
+// Name returns the name of the rule.
+func (r ContributionRule) Name() string {
+	return r.Contribution
+}
+
+// Apply adds the contribution to the calculation.
+func (r ContributionRule) Apply(calc *CalculationContext) *Err {
+	earnings := calc.ContributionEarnings
+	if r.MaxEarnings > 0 && earnings > r.MaxEarnings {
+		earnings = r.MaxEarnings
+	}
+	contribution := math.Max(earnings-r.Exemption, 0) * r.Rate
+	calc.Contributions = append(calc.Contributions, Adjustment{Name: r.Contribution, Amount: calc.Rounding.Round(contribution)})
+	return nil
+}
+
+// roundCents rounds the amount to the nearest cent.
+func roundCents(amount float64) float64 {
+	return math.Round(exactScaled(amount, 100)) / 100
+}
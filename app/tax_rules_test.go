@@ -0,0 +1,138 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestRunTaxRules tests that the rules of a pipeline are applied in order to the shared context.
+func TestRunTaxRules(t *testing.T) {
+	brackets := []TaxBracket{
+		{Min: 0, Max: 10000, Rate: 0.10},
+		{Min: 10000, Rate: 0.20},
+	}
+	var tests = []struct {
+		name          string
+		salary        float64
+		rules         []TaxRule
+		totalTax      float64
+		contributions []Adjustment
+	}{
+		{
+			"bracket tax",
+			50000,
+			[]TaxRule{BracketTaxRule{}},
+			9000,
+			nil,
+		},
+		{
+			"non-refundable credit",
+			5000,
+			[]TaxRule{BracketTaxRule{}, CreditRule{Credit: "basic_personal_amount", Amount: 10000, Rate: 0.10}},
+			0,
+			nil,
+		},
+		{
+			"refundable credit",
+			5000,
+			[]TaxRule{BracketTaxRule{}, CreditRule{Credit: "abatement", Amount: 10000, Rate: 0.10, Refundable: true}},
+			-500,
+			nil,
+		},
+		{
+			"surtax",
+			50000,
+			[]TaxRule{BracketTaxRule{}, SurtaxRule{Surtax: "surtax", Threshold: 5000, Rate: 0.20}},
+			9800,
+			nil,
+		},
+		{
+			"surtaxes on the same tax",
+			50000,
+			[]TaxRule{BracketTaxRule{}, SurtaxRule{Surtax: "surtax", Threshold: 5000, Rate: 0.20}, SurtaxRule{Surtax: "surtax", Threshold: 8000, Rate: 0.36}},
+			10160,
+			nil,
+		},
+		{
+			"capped clawback",
+			50000,
+			[]TaxRule{BracketTaxRule{}, ClawbackRule{Clawback: "clawback", Threshold: 40000, Rate: 0.15, MaxAmount: 1000}},
+			10000,
+			nil,
+		},
+		{
+			"contribution",
+			50000,
+			[]TaxRule{BracketTaxRule{}, ContributionRule{Contribution: "pension", Rate: 0.05, Exemption: 3500, MaxEarnings: 40000}},
+			9000,
+			[]Adjustment{{Name: "pension", Amount: 1825}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculationContext("2022", JurisdictionCanada, tt.salary, brackets)
+			if err := RunTaxRules(calc, tt.rules); err != nil {
+				t.Fatalf("got error %v, want nil", *err)
+			}
+			if calc.TotalTax() != tt.totalTax {
+				t.Errorf("got total tax %v, want %v", calc.TotalTax(), tt.totalTax)
+			}
+			if len(calc.Contributions) != len(tt.contributions) {
+				t.Fatalf("got contributions %v, want %v", calc.Contributions, tt.contributions)
+			}
+			for i, v := range calc.Contributions {
+				if v != tt.contributions[i] {
+					t.Errorf("i: %d got %v, want %v", i, v, tt.contributions[i])
+				}
+			}
+		})
+	}
+}
+
+// TestGetTaxRulePipeline tests that every supported year has a calculation pipeline.
+func TestGetTaxRulePipeline(t *testing.T) {
+	for year := range TaxBrackets {
+		if _, err := GetTaxRulePipeline(JurisdictionCanada, year); err != nil {
+			t.Errorf("got error %v for the tax year %v, want nil", *err, year)
+		}
+	}
+	for year := range USFederalTaxBrackets {
+		if _, err := GetTaxRulePipeline(JurisdictionUS, year); err != nil {
+			t.Errorf("got error %v for the US tax year %v, want nil", *err, year)
+		}
+	}
+	if _, err := GetTaxRulePipeline(JurisdictionCanada, "1999"); err == nil {
+		t.Errorf("got no error for the tax year 1999, want error")
+	}
+}
+
+// TestTaxRulePipelinesDataset tests that the tax rule pipelines are built from the dataset and validated.
+func TestTaxRulePipelinesDataset(t *testing.T) {
+	rules := TaxRulePipelines[JurisdictionCanada+"-"+ProvinceQuebec]["2023"]
+	if _, ok := rules[0].(AbatementRule); !ok {
+		t.Errorf("got the first Quebec rule %T, want the abatement", rules[0])
+	}
+	if ans := TaxRulePipelines[JurisdictionCanada]["2000"]; !reflect.DeepEqual(ans, []TaxRule{BracketTaxRule{}}) {
+		t.Errorf("got %v for a federal year without a pipeline, want the brackets only", ans)
+	}
+
+	var tests = []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{"surtax", `{"rule": "surtax", "name": "surtax", "threshold": 1000, "rate": 0.2}`, false},
+		{"unknown rule", `{"rule": "bonus", "name": "bonus", "rate": 0.1}`, true},
+		{"no name", `{"rule": "credit", "amount": 1000, "rate": 0.1}`, true},
+		{"invalid rate", `{"rule": "contribution", "name": "cpp", "rate": 1.5}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `{"years": [], "tax_rule_pipelines": {"CA-ON": {"2023": [` + tt.rule + `]}}}`
+			_, err := LoadTaxBracketDataset([]byte(data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Symbolic years, resolved from the dataset and the server clock.
+const (
+	// YearLatest is the most recent year of the dataset.
+	YearLatest = "latest"
+	// YearCurrent is the year of the server clock or, until its tax brackets are loaded, the most recent year
+	// of the dataset before it.
+	YearCurrent = "current"
+	// YearPrevious is the year before the current year.
+	YearPrevious = "previous"
+)
+
+// LatestTaxYear returns the most recent year of the federal tax bracket dataset.
+func LatestTaxYear() string {
+	return FederalTaxYears.Years[len(FederalTaxYears.Years)-1].Year
+}
+
+// ResolveYear resolves a symbolic year to a year of the dataset at the time now. Other years are returned as is.
+func ResolveYear(year string, now time.Time) (string, *Err) {
+	switch year {
+	case YearLatest:
+		return LatestTaxYear(), nil
+	case YearCurrent:
+		return currentTaxYear(now)
+	case YearPrevious:
+		current, err := currentTaxYear(now)
+		if err != nil {
+			return "", err
+		}
+		previous, _ := strconv.Atoi(current)
+		return resolvedTaxYear(YearPrevious, strconv.Itoa(previous-1))
+	default:
+		return year, nil
+	}
+}
+
+// currentTaxYear returns the year of the clock, or the most recent year of the dataset before it.
+func currentTaxYear(now time.Time) (string, *Err) {
+	clockYear := strconv.Itoa(now.Year())
+	for i := len(FederalTaxYears.Years) - 1; i >= 0; i-- {
+		// The years of the dataset are sorted and have 4 digits.
+		if year := FederalTaxYears.Years[i].Year; year <= clockYear {
+			return year, nil
+		}
+	}
+	return resolvedTaxYear(YearCurrent, clockYear)
+}
+
+// resolvedTaxYear returns the year a symbolic year resolves to, if it is in the dataset.
+func resolvedTaxYear(symbolicYear string, year string) (string, *Err) {
+	if _, ok := TaxBrackets[year]; !ok {
+		return "", &Err{
+			Code:    http.StatusNotFound,
+			Field:   "year",
+			Message: fmt.Sprintf("tax brackets for the tax year '%v' (%v) is not found", year, symbolicYear),
+		}
+	}
+	return year, nil
+}
+
+// resolveYear resolves a symbolic year with the clock of the service.
+func (s *TaxService) resolveYear(year string) (string, *Err) {
+	return ResolveYear(year, s.Clock())
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+	"testing"
+	"time"
+)
+
+// TestResolveYear tests the ResolveYear function.
+func TestResolveYear(t *testing.T) {
+	var tests = []struct {
+		year  string
+		clock int
+		want  string
+		error int
+	}{
+		{YearLatest, 2026, "2023", 0},
+		{YearCurrent, 2026, "2023", 0},
+		{YearPrevious, 2026, "2022", 0},
+		{YearCurrent, 2021, "2021", 0},
+		{YearPrevious, 2021, "2020", 0},
+		{YearCurrent, 2000, "2000", 0},
+		{YearPrevious, 2000, "", http.StatusNotFound},
+		{YearCurrent, 1999, "", http.StatusNotFound},
+		{"2010", 2026, "2010", 0},
+		{"1999", 2026, "1999", 0},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v/%d", tt.year, tt.clock)
+		t.Run(testname, func(t *testing.T) {
+			ans, err := ResolveYear(tt.year, time.Date(tt.clock, time.June, 1, 0, 0, 0, 0, time.UTC))
+			if err != nil {
+				if err.Code != tt.error {
+					t.Errorf("got error %v, want code %v", *err, tt.error)
+				}
+				return
+			}
+			if tt.error != 0 {
+				t.Fatalf("got no error, want code %v", tt.error)
+			}
+			if ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+// TestSymbolicYearHandlers tests that the handlers resolve symbolic years and report the resolved year.
+func TestSymbolicYearHandlers(t *testing.T) {
+	s := &TaxService{Clock: func() time.Time { return time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC) }}
+
+	root, err := s.GetTaxCalculator(context.Background(), api.GetTaxCalculatorRequestObject{})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if got := root.(api.GetTaxCalculator200JSONResponse).Headers.XTaxYear; got != "2023" {
+		t.Errorf("got root year %v, want the latest year 2023", got)
+	}
+
+	brackets, err := s.GetTaxCalculatorByYear(context.Background(), api.GetTaxCalculatorByYearRequestObject{Year: YearCurrent})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if got := brackets.(api.GetTaxCalculatorByYear200JSONResponse).Headers.XTaxYear; got != "2021" {
+		t.Errorf("got current year %v, want 2021", got)
+	}
+
+	calculation, err := s.Calculate(context.Background(), api.CalculateRequestObject{Year: YearPrevious, Body: &api.CalculateRequest{Salary: 50000}})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	response := calculation.(api.Calculate200JSONResponse)
+	if response.Headers.XTaxYear != "2020" || response.Body.TaxYear != "2020" {
+		t.Errorf("got previous year %v and tax year %v, want 2020", response.Headers.XTaxYear, response.Body.TaxYear)
+	}
+}
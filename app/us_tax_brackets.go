@@ -0,0 +1,123 @@
+package main
+
+// Jurisdictions supported by the tax calculator.
+const (
+	JurisdictionCanada = "CA"
+	JurisdictionUS     = "US"
+)
+
+// Filing statuses supported by the US federal jurisdiction.
+const (
+	FilingStatusSingle                  = "single"
+	FilingStatusMarriedFilingJointly    = "married_filing_jointly"
+	FilingStatusMarriedFilingSeparately = "married_filing_separately"
+	FilingStatusHeadOfHousehold         = "head_of_household"
+)
+
+// FilingStatusBrackets represents the standard deduction and tax brackets for a US filing status.
+type FilingStatusBrackets struct {
+	StandardDeduction float64      `json:"standard_deduction"`
+	Brackets          []TaxBracket `json:"brackets"`
+}
+
+// USFederalTaxBrackets represents the US federal tax brackets for all supported years, by filing status.
+var USFederalTaxBrackets = map[string]map[string]FilingStatusBrackets{
+	"2019": {
+		FilingStatusSingle: {
+			StandardDeduction: 12200,
+			Brackets:          usBrackets(9700, 39475, 84200, 160725, 204100, 510300),
+		},
+		FilingStatusMarriedFilingJointly: {
+			StandardDeduction: 24400,
+			Brackets:          usBrackets(19400, 78950, 168400, 321450, 408200, 612350),
+		},
+		FilingStatusMarriedFilingSeparately: {
+			StandardDeduction: 12200,
+			Brackets:          usBrackets(9700, 39475, 84200, 160725, 204100, 306175),
+		},
+		FilingStatusHeadOfHousehold: {
+			StandardDeduction: 18350,
+			Brackets:          usBrackets(13850, 52850, 84200, 160700, 204100, 510300),
+		},
+	},
+	"2020": {
+		FilingStatusSingle: {
+			StandardDeduction: 12400,
+			Brackets:          usBrackets(9875, 40125, 85525, 163300, 207350, 518400),
+		},
+		FilingStatusMarriedFilingJointly: {
+			StandardDeduction: 24800,
+			Brackets:          usBrackets(19750, 80250, 171050, 326600, 414700, 622050),
+		},
+		FilingStatusMarriedFilingSeparately: {
+			StandardDeduction: 12400,
+			Brackets:          usBrackets(9875, 40125, 85525, 163300, 207350, 311025),
+		},
+		FilingStatusHeadOfHousehold: {
+			StandardDeduction: 18650,
+			Brackets:          usBrackets(14100, 53700, 85500, 163300, 207350, 518400),
+		},
+	},
+	"2021": {
+		FilingStatusSingle: {
+			StandardDeduction: 12550,
+			Brackets:          usBrackets(9950, 40525, 86375, 164925, 209425, 523600),
+		},
+		FilingStatusMarriedFilingJointly: {
+			StandardDeduction: 25100,
+			Brackets:          usBrackets(19900, 81050, 172750, 329850, 418850, 628300),
+		},
+		FilingStatusMarriedFilingSeparately: {
+			StandardDeduction: 12550,
+			Brackets:          usBrackets(9950, 40525, 86375, 164925, 209425, 314150),
+		},
+		FilingStatusHeadOfHousehold: {
+			StandardDeduction: 18800,
+			Brackets:          usBrackets(14200, 54200, 86350, 164900, 209400, 523600),
+		},
+	},
+	"2022": {
+		FilingStatusSingle: {
+			StandardDeduction: 12950,
+			Brackets:          usBrackets(10275, 41775, 89075, 170050, 215950, 539900),
+		},
+		FilingStatusMarriedFilingJointly: {
+			StandardDeduction: 25900,
+			Brackets:          usBrackets(20550, 83550, 178150, 340100, 431900, 647850),
+		},
+		FilingStatusMarriedFilingSeparately: {
+			StandardDeduction: 12950,
+			Brackets:          usBrackets(10275, 41775, 89075, 170050, 215950, 323925),
+		},
+		FilingStatusHeadOfHousehold: {
+			StandardDeduction: 19400,
+			Brackets:          usBrackets(14650, 55900, 89050, 170050, 215950, 539900),
+		},
+	},
+	"2023": {
+		FilingStatusSingle: {
+			StandardDeduction: 13850,
+			Brackets:          usBrackets(11000, 44725, 95375, 182100, 231250, 578125),
+		},
+		FilingStatusMarriedFilingJointly: {
+			StandardDeduction: 27700,
+			Brackets:          usBrackets(22000, 89450, 190750, 364200, 462500, 693750),
+		},
+		FilingStatusMarriedFilingSeparately: {
+			StandardDeduction: 13850,
+			Brackets:          usBrackets(11000, 44725, 95375, 182100, 231250, 346875),
+		},
+		FilingStatusHeadOfHousehold: {
+			StandardDeduction: 20800,
+			Brackets:          usBrackets(15700, 59850, 95350, 182100, 231250, 578100),
+		},
+	},
+}
+
+// usFederalRates are the US federal marginal rates, unchanged since the 2018 tax year.
+var usFederalRates = []float64{0.10, 0.12, 0.22, 0.24, 0.32, 0.35, 0.37}
+
+// usBrackets builds the seven US federal tax brackets from the upper limit of each of the first six.
+func usBrackets(thresholds ...float64) []TaxBracket {
+	return bracketsFromThresholds(usFederalRates, thresholds...)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"patrickyau/interview-test-server/api"
+)
+
+// GetTaxYearsV2 returns the federal tax brackets of every year, sorted by year.
+func (s *TaxService) GetTaxYearsV2(ctx context.Context, request api.GetTaxYearsV2RequestObject) (api.GetTaxYearsV2ResponseObject, error) {
+	cacheHeaders := NewCacheHeaders("", "", ContentTypeJSON)
+	if cacheHeaders.NotModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return api.GetTaxYearsV2304Response{
+			Headers: api.GetTaxYearsV2304ResponseHeaders{
+				CacheControl: cacheHeaders.CacheControl,
+				ETag:         cacheHeaders.ETag,
+				LastModified: cacheHeaders.LastModified,
+			},
+		}, nil
+	}
+	response := api.GetTaxYearsV2200JSONResponse{
+		Body: api.TaxYearsV2{
+			TaxYears: make([]api.TaxYearV2, len(FederalTaxYears.Years)),
+		},
+		Headers: api.GetTaxYearsV2200ResponseHeaders{
+			XDatasetVersion: DatasetVersion,
+			CacheControl:    cacheHeaders.CacheControl,
+			ETag:            cacheHeaders.ETag,
+			LastModified:    cacheHeaders.LastModified,
+		},
+	}
+	for i, taxYear := range FederalTaxYears.Years {
+		response.Body.TaxYears[i] = mapTaxYearToAPITaxYearV2(taxYear)
+	}
+	return response, nil
+}
+
+// GetTaxYearV2 returns the federal tax brackets of the given year.
+func (s *TaxService) GetTaxYearV2(ctx context.Context, request api.GetTaxYearV2RequestObject) (api.GetTaxYearV2ResponseObject, error) {
+	taxYear, err := s.taxYear(request.Year)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.GetTaxYearV2404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.GetTaxYearV2400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+
+	year := taxYear.Year
+	cacheHeaders := NewCacheHeaders(request.Year, year, ContentTypeJSON)
+	if cacheHeaders.NotModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return api.GetTaxYearV2304Response{
+			Headers: api.GetTaxYearV2304ResponseHeaders{
+				CacheControl: cacheHeaders.CacheControl,
+				ETag:         cacheHeaders.ETag,
+				LastModified: cacheHeaders.LastModified,
+			},
+		}, nil
+	}
+	return api.GetTaxYearV2200JSONResponse{
+		Body: mapTaxYearToAPITaxYearV2(taxYear),
+		Headers: api.GetTaxYearV2200ResponseHeaders{
+			XTaxYear:        year,
+			XDatasetVersion: DatasetVersion,
+			CacheControl:    cacheHeaders.CacheControl,
+			ETag:            cacheHeaders.ETag,
+			LastModified:    cacheHeaders.LastModified,
+		},
+	}, nil
+}
+
+// taxYear resolves the year and returns its federal tax brackets.
+func (s *TaxService) taxYear(year string) (TaxYear, *Err) {
+	year, err := s.resolveYear(year)
+	if err != nil {
+		return TaxYear{}, err
+	}
+	if err := ValidateYear(year); err != nil {
+		return TaxYear{}, err
+	}
+	return FederalTaxYears.Year(year)
+}
+
+// CalculateV2 calculates the tax for the year, like Calculate, and returns it in the typed v2 schema.
+func (s *TaxService) CalculateV2(ctx context.Context, request api.CalculateV2RequestObject) (api.CalculateV2ResponseObject, error) {
+	taxOwed, err := s.calculate(request.Year, *request.Body)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return api.CalculateV2404ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+		}
+		return api.CalculateV2400ApplicationProblemPlusJSONResponse(NewProblem(ctx, err)), nil
+	}
+	return api.CalculateV2200JSONResponse{
+		Body: mapTaxOwedToAPICalculateResponseV2(taxOwed),
+		Headers: api.CalculateV2200ResponseHeaders{
+			XTaxYear: taxOwed.TaxYear,
+		},
+	}, nil
+}
+
+// mapTaxYearToAPITaxYearV2 maps the federal TaxYear to an api.TaxYearV2.
+func mapTaxYearToAPITaxYearV2(taxYear TaxYear) api.TaxYearV2 {
+	response := api.TaxYearV2{
+		Year:           taxYear.Year,
+		Jurisdiction:   JurisdictionCanada,
+		Currency:       JurisdictionCurrencies[JurisdictionCanada],
+		Brackets:       make([]api.TaxBracketV2, len(taxYear.Brackets)),
+		DatasetVersion: DatasetVersion,
+	}
+	for i, bracket := range taxYear.Brackets {
+		response.Brackets[i] = api.TaxBracketV2{
+			Min:  bracket.Min,
+			Max:  bracketMax(bracket),
+			Rate: bracket.Rate,
+		}
+	}
+	if taxYear.Provenance != (Provenance{}) {
+		provenance := mapProvenanceToAPITaxYearProvenance(taxYear.Year, taxYear.Provenance)
+		response.Provenance = &provenance
+	}
+	return response
+}
+
+// mapTaxOwedToAPICalculateResponseV2 maps a TaxOwed to an api.CalculateResponseV2, where every amount is present.
+func mapTaxOwedToAPICalculateResponseV2(taxOwed TaxOwed) api.CalculateResponseV2 {
+	response := api.CalculateResponseV2{
+		TaxYear:           taxOwed.TaxYear,
+		Jurisdiction:      taxOwed.Jurisdiction,
+		Currency:          JurisdictionCurrencies[taxOwed.Jurisdiction],
+		FilingStatus:      optionalString(taxOwed.FilingStatus),
+		Province:          optionalString(taxOwed.Province),
+		Salary:            taxOwed.Salary,
+		TotalIncome:       taxOwed.TotalIncome,
+		Deductions:        taxOwed.Deductions,
+		TaxableIncome:     taxOwed.TaxableIncome,
+		FederalTaxOwed:    taxOwed.FederalTaxOwed,
+		ProvincialTaxOwed: taxOwed.ProvincialTaxOwed,
+		TotalTaxOwed:      taxOwed.TotalTaxOwed,
+		EffectiveTaxRate:  taxOwed.EffectiveRate,
+		MarginalTaxRate:   taxOwed.MarginalTaxRate,
+		NetIncome:         taxOwed.NetIncome,
+		Bands:             mapTaxBracketsToAPIBandsV2(taxOwed.TaxableIncome, taxOwed.TaxOwedPerBand),
+		ProvincialBands:   mapTaxBracketsToAPIBandsV2(taxOwed.TaxableIncome, taxOwed.ProvincialTaxOwedPerBand),
+		Credits:           []api.Adjustment{},
+		Contributions:     []api.Adjustment{},
+		IncomeItems:       []api.IncomeItem{},
+		TaxWithheld:       taxOwed.TaxWithheld,
+		InstalmentsPaid:   taxOwed.InstalmentsPaid,
+		Refund:            taxOwed.Refund,
+		BalanceOwing:      taxOwed.BalanceOwing,
+		Rounding: api.RoundingPolicy{
+			Mode:                  taxOwed.Rounding.Mode,
+			Method:                taxOwed.Rounding.Method,
+			Precision:             taxOwed.Rounding.Precision,
+			EffectiveRateDecimals: taxOwed.Rounding.EffectiveRateDecimals,
+		},
+		DatasetVersion: taxOwed.DatasetVersion,
+	}
+	if taxOwed.Jurisdiction == JurisdictionUS {
+		standardDeduction := taxOwed.StandardDeduction
+		response.StandardDeduction = &standardDeduction
+	}
+	if taxOwed.Province != "" {
+		response.ProvincialTaxAuthority = optionalString(ProvincialTaxAuthority(taxOwed.Province))
+	}
+	if len(taxOwed.Credits) > 0 {
+		response.Credits = mapAdjustmentsToAPIAdjustments(taxOwed.Credits)
+	}
+	if len(taxOwed.Contributions) > 0 {
+		response.Contributions = mapAdjustmentsToAPIAdjustments(taxOwed.Contributions)
+	}
+	if len(taxOwed.IncomeItems) > 0 {
+		response.IncomeItems = mapIncomeItemsToAPIIncomeItems(taxOwed.IncomeItems)
+	}
+	if taxOwed.Residency != nil {
+		response.Residency = &api.Residency{
+			Status:                taxOwed.Residency.Status,
+			DaysResident:          taxOwed.Residency.DaysResident,
+			DaysInYear:            taxOwed.Residency.DaysInYear,
+			CreditProrationFactor: taxOwed.Residency.CreditProrationFactor,
+			IncomeProrationFactor: taxOwed.Residency.IncomeProrationFactor,
+		}
+	}
+	if taxOwed.Provenance != nil {
+		provenance := mapProvenanceToAPITaxYearProvenance(taxOwed.TaxYear, *taxOwed.Provenance)
+		response.Provenance = &provenance
+	}
+	return response
+}
+
+// mapTaxBracketsToAPIBandsV2 maps the tax owed per band to api.BandV2, with the part of the taxable income in every band.
+func mapTaxBracketsToAPIBandsV2(taxableIncome float64, taxOwedPerBand []TaxBracket) []api.BandV2 {
+	bands := make([]api.BandV2, len(taxOwedPerBand))
+	for i, bracket := range taxOwedPerBand {
+		upper := taxableIncome
+		if bracket.Max > 0 && bracket.Max < taxableIncome {
+			upper = bracket.Max
+		}
+		bands[i] = api.BandV2{
+			Min:           bracket.Min,
+			Max:           bracketMax(bracket),
+			Rate:          bracket.Rate,
+			TaxableAmount: upper - bracket.Min,
+			TaxOwed:       bracket.TaxOwed,
+		}
+	}
+	return bands
+}
+
+// bracketMax returns the upper limit of the bracket, or nil for the open-ended top bracket stored with a max of 0.
+func bracketMax(bracket TaxBracket) *float64 {
+	if bracket.Max == 0 {
+		return nil
+	}
+	max := bracket.Max
+	return &max
+}
+
+// optionalString returns a pointer to the value, or nil if it is empty.
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"patrickyau/interview-test-server/api"
+	"strings"
+	"testing"
+)
+
+// TestGetTaxYearV2 tests the GetTaxYearV2 handler.
+func TestGetTaxYearV2(t *testing.T) {
+	s := NewTaxService()
+	var tests = []struct {
+		year string
+		want string
+	}{
+		{"2022", "api.GetTaxYearV2200JSONResponse"},
+		{YearLatest, "api.GetTaxYearV2200JSONResponse"},
+		{"1999", "api.GetTaxYearV2404ApplicationProblemPlusJSONResponse"},
+		{"20x0", "api.GetTaxYearV2400ApplicationProblemPlusJSONResponse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.year, func(t *testing.T) {
+			ans, err := s.GetTaxYearV2(context.Background(), api.GetTaxYearV2RequestObject{Year: tt.year})
+			if err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if got := fmt.Sprintf("%T", ans); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			if response, ok := ans.(api.GetTaxYearV2200JSONResponse); ok {
+				brackets := response.Body.Brackets
+				if response.Body.Currency != CurrencyCAD || response.Body.Provenance == nil || len(brackets) == 0 {
+					t.Fatalf("got %+v, want the CAD brackets and their provenance", response.Body)
+				}
+				for i, bracket := range brackets {
+					if last := i == len(brackets)-1; last != (bracket.Max == nil) {
+						t.Errorf("got max %v for bracket %d, want nil only for the last bracket", bracket.Max, i)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestGetTaxYearsV2 tests that the GetTaxYearsV2 handler returns every year, sorted by year.
+func TestGetTaxYearsV2(t *testing.T) {
+	s := NewTaxService()
+	ans, err := s.GetTaxYearsV2(context.Background(), api.GetTaxYearsV2RequestObject{})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	taxYears := ans.(api.GetTaxYearsV2200JSONResponse).Body.TaxYears
+	if len(taxYears) != len(TaxBrackets) {
+		t.Fatalf("got %d tax years, want %d", len(taxYears), len(TaxBrackets))
+	}
+	for i := 1; i < len(taxYears); i++ {
+		if taxYears[i-1].Year >= taxYears[i].Year {
+			t.Errorf("got %v before %v, want the years sorted", taxYears[i-1].Year, taxYears[i].Year)
+		}
+	}
+}
+
+// TestCalculateV2 tests that the v2 calculation serves the same results as v1 in the typed schema.
+func TestCalculateV2(t *testing.T) {
+	router, err := NewRouter(NewTaxService())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	var tests = []struct {
+		name              string
+		year              string
+		body              string
+		totalTaxOwed      float64
+		effectiveTaxRate  float64
+		marginalTaxRate   float64
+		currency          string
+		openEndedTopBand  bool
+		provincialBands   int
+		standardDeduction bool
+	}{
+		{"no salary", "2022", `{"salary": 0}`, 0, 0, 0.15, CurrencyCAD, false, 0, false},
+		{"first bracket", "2022", `{"salary": 50000}`, 7500, 0.15, 0.15, CurrencyCAD, false, 0, false},
+		{"top bracket", "2022", `{"salary": 1234567}`, 385587.65, 0.3123, 0.33, CurrencyCAD, true, 0, false},
+		{"province", "2023", `{"salary": 100000, "province": "ON"}`, 22128.14, 0.2213, 0.3166, CurrencyCAD, false, 3, false},
+		{"US", "2023", `{"salary": 100000, "jurisdiction": "US", "filing_status": "single"}`, 14261, 0.1426, 0.22, "USD", false, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tax-calculator/v2/tax-years/"+tt.year+"/calculate", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %v, want %v: %v", rec.Code, http.StatusOK, rec.Body.String())
+			}
+
+			var fields map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &fields); err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			for _, field := range []string{"tax_owed_per_band", "tax_owned_per_band"} {
+				if _, ok := fields[field]; ok {
+					t.Errorf("got the v1 field %v, want bands", field)
+				}
+			}
+			for _, field := range []string{"total_tax_owed", "federal_tax_owed", "provincial_tax_owed", "refund", "balance_owing", "bands", "credits"} {
+				if _, ok := fields[field]; !ok {
+					t.Errorf("got no %v, want it always present", field)
+				}
+			}
+
+			var response api.CalculateResponseV2
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+			if response.TotalTaxOwed != tt.totalTaxOwed {
+				t.Errorf("got total tax owed %v, want %v", response.TotalTaxOwed, tt.totalTaxOwed)
+			}
+			if response.EffectiveTaxRate != tt.effectiveTaxRate {
+				t.Errorf("got effective tax rate %v, want %v", response.EffectiveTaxRate, tt.effectiveTaxRate)
+			}
+			if response.MarginalTaxRate != tt.marginalTaxRate {
+				t.Errorf("got marginal tax rate %v, want %v", response.MarginalTaxRate, tt.marginalTaxRate)
+			}
+			if response.Currency != tt.currency {
+				t.Errorf("got currency %v, want %v", response.Currency, tt.currency)
+			}
+			if n := len(response.Bands); n > 0 && (response.Bands[n-1].Max == nil) != tt.openEndedTopBand {
+				t.Errorf("got top band max %v, want open-ended %v", response.Bands[n-1].Max, tt.openEndedTopBand)
+			}
+			var bandSum float64
+			for _, band := range response.Bands {
+				bandSum += band.TaxableAmount
+			}
+			if response.TaxableIncome > 0 && bandSum != response.TaxableIncome {
+				t.Errorf("got taxable amounts summing to %v, want %v", bandSum, response.TaxableIncome)
+			}
+			if len(response.ProvincialBands) != tt.provincialBands {
+				t.Errorf("got %d provincial bands, want %d", len(response.ProvincialBands), tt.provincialBands)
+			}
+			if (response.StandardDeduction != nil) != tt.standardDeduction {
+				t.Errorf("got standard deduction %v, want it present %v", response.StandardDeduction, tt.standardDeduction)
+			}
+		})
+	}
+}
+
+// TestCalculateV2NotFound tests that the v2 calculation reports a missing year.
+func TestCalculateV2NotFound(t *testing.T) {
+	s := NewTaxService()
+	ans, err := s.CalculateV2(context.Background(), api.CalculateV2RequestObject{Year: "1999", Body: &api.CalculateRequest{Salary: 50000}})
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	if _, ok := ans.(api.CalculateV2404ApplicationProblemPlusJSONResponse); !ok {
+		t.Errorf("got %T, want api.CalculateV2404ApplicationProblemPlusJSONResponse", ans)
+	}
+}